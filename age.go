@@ -0,0 +1,52 @@
+package please
+
+import "time"
+
+// Age returns the age in full years of the person born at the birth time at the current time. The birth date is
+// the calendar date of the birth time in its location, and today is the calendar date of the current time in its location,
+// so use a clock in the location of the person, like ClockIn(SystemClock(), loc), to change the age at their midnight.
+// A person born on February 29 becomes a year older on March 1 in non-leap years. The age is negative if the birth is in the future.
+func Age(birth, now time.Time) int {
+	years := now.Year() - birth.Year()
+	birthday := time.Date(now.Year(), birth.Month(), birth.Day(), 0, 0, 0, 0, time.UTC)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if today.Before(birthday) {
+		years--
+	}
+	return years
+}
+
+// MinAge returns a validation function that checks whether the person born at the birth time is at least the years old
+// at the current time of the clock, like StringTime(time.DateOnly, MinAge(SystemClock(), 18)) for a date of birth.
+func MinAge(clock Clock, years int) Validate[time.Time] {
+	return func(birth time.Time) error {
+		if Age(birth, clock.Now()) < years {
+			return errorf("must be at least %d years old", years)
+		}
+		return nil
+	}
+}
+
+// MaxAge returns a validation function that checks whether the person born at the birth time is at most the years old
+// at the current time of the clock.
+func MaxAge(clock Clock, years int) Validate[time.Time] {
+	return func(birth time.Time) error {
+		if Age(birth, clock.Now()) > years {
+			return errorf("must be at most %d years old", years)
+		}
+		return nil
+	}
+}
+
+// AgeBetween returns a validation function that checks whether the age of the person born at the birth time
+// is between the years at the current time of the clock.
+func AgeBetween(clock Clock, x, y int) Validate[time.Time] {
+	return func(birth time.Time) error {
+		minimal := min(x, y)
+		maximal := max(x, y)
+		if age := Age(birth, clock.Now()); age < minimal || age > maximal {
+			return errorf("must be between %d and %d years old", minimal, maximal)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,80 @@
+package please
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestAgeLeapDay(t *testing.T) {
+	birth := date(2004, time.February, 29)
+	tests := []struct {
+		now  time.Time
+		want int
+	}{
+		{date(2022, time.February, 28), 17},
+		{date(2022, time.March, 1), 18},
+		{date(2024, time.February, 28), 19},
+		{date(2024, time.February, 29), 20},
+		{date(2003, time.January, 1), -2},
+	}
+	for _, tt := range tests {
+		if got := Age(birth, tt.now); got != tt.want {
+			t.Errorf("Age at %s = %d, want %d", tt.now.Format(time.DateOnly), got, tt.want)
+		}
+	}
+}
+
+func TestAgeTimeZone(t *testing.T) {
+	almaty := time.FixedZone("UTC+6", 6*60*60)
+	birth, err := time.Parse(time.DateOnly, "2006-05-10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2024-05-10 01:00 in UTC+6 is still 2024-05-09 in UTC.
+	now := time.Date(2024, time.May, 9, 19, 0, 0, 0, time.UTC)
+	if got := Age(birth, now.In(almaty)); got != 18 {
+		t.Errorf("got %d in UTC+6, want 18", got)
+	}
+	if got := Age(birth, now); got != 17 {
+		t.Errorf("got %d in UTC, want 17", got)
+	}
+
+	clock := ClockIn(FixedClock(now), almaty)
+	if err := StringTime(time.DateOnly, MinAge(clock, 18))("2006-05-10"); err != nil {
+		t.Errorf("got %v", err)
+	}
+	if err := StringTime(time.DateOnly, MinAge(FixedClock(now), 18))("2006-05-10"); err == nil {
+		t.Error("underage birth date passed in UTC")
+	}
+}
+
+func TestAgeRules(t *testing.T) {
+	clock := FixedClock(date(2024, time.May, 10))
+	tests := []struct {
+		name  string
+		rule  Validate[time.Time]
+		birth time.Time
+		want  string
+	}{
+		{"min age", MinAge(clock, 18), date(2006, time.May, 11), "must be at least 18 years old"},
+		{"min age birthday", MinAge(clock, 18), date(2006, time.May, 10), ""},
+		{"max age", MaxAge(clock, 65), date(1958, time.May, 10), "must be at most 65 years old"},
+		{"between", AgeBetween(clock, 30, 18), date(1990, time.January, 1), "must be between 18 and 30 years old"},
+		{"between ok", AgeBetween(clock, 18, 30), date(2000, time.January, 1), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if err := tt.rule(tt.birth); err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package please
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Budget limits the errors collected when executing the validation functions.
+type Budget struct {
+	// Max is the maximum number of errors to collect, zero means no limit.
+	// When the limit is reached, the execution stops and a BudgetError is appended to the collected errors.
+	Max int
+	// FirstPerPath keeps only the first error of the validated value and of each collection element.
+	FirstPerPath bool
+	// Count continues the execution after the limit is reached to count the omitted errors,
+	// so the BudgetError reports how many errors were omitted.
+	Count bool
+}
+
+// BudgetError is the summary error appended to the collected errors when the error budget is exceeded.
+type BudgetError struct {
+	// Max is the maximum number of collected errors.
+	Max int
+	// Omitted is the number of omitted errors, it is known only if the execution was not stopped.
+	Omitted int
+	// Stopped reports whether the execution was stopped after reaching the limit.
+	Stopped bool
+}
+
+// Error returns the summary of the omitted errors.
+func (e *BudgetError) Error() string {
+	if e.Stopped && e.Max == 1 {
+		return "stopped after 1 error"
+	}
+	if e.Stopped {
+		return fmt.Sprintf("stopped after %d errors", e.Max)
+	}
+	if e.Omitted == 1 {
+		return "and 1 more error"
+	}
+	return fmt.Sprintf("and %d more errors", e.Omitted)
+}
+
+// collector accounts the errors collected by the validation functions within the budget.
+type collector struct {
+	budget  Budget
+	count   int
+	omitted int
+	stopped bool
+}
+
+// exhausted reports whether the execution must stop because an error was refused after the budget was exhausted.
+func (c *collector) exhausted() bool {
+	return c.stopped
+}
+
+// keep accounts the error in the budget and reports whether it must be retained.
+// An error over the limit stops the execution, unless the omitted errors are counted.
+func (c *collector) keep() bool {
+	if c.budget.Max > 0 && c.count >= c.budget.Max {
+		c.omitted++
+		c.stopped = !c.budget.Count
+		return false
+	}
+	c.count++
+	return true
+}
+
+// summarize appends the summary error to the collected errors if the budget is exceeded.
+func (c *collector) summarize(errs []error) []error {
+	if !c.stopped && c.omitted == 0 {
+		return errs
+	}
+	return append(errs, &BudgetError{Max: c.budget.Max, Omitted: c.omitted, Stopped: c.stopped})
+}
+
+// collect executes the validation functions within the budget of the collector and returns the retained errors.
+func collect[T any](c *collector, value T, opts []Validate[T]) []error {
+	var errs []error
+	for _, v := range opts {
+		if c.exhausted() {
+			break
+		}
+		err := v(value)
+		if err == nil {
+			continue
+		}
+		if c.keep() {
+			errs = append(errs, err)
+		}
+		if c.budget.FirstPerPath {
+			break
+		}
+	}
+	return errs
+}
+
+// CollectBudget collects the errors within the budget when executing the validation functions.
+func CollectBudget[T any](value T, budget Budget, opts ...Validate[T]) []error {
+	c := &collector{budget: budget}
+	errs := c.summarize(collect(c, value, opts))
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// JoinFuncBudget joins the errors collected within the budget using the specified join function when executing the validation functions.
+func JoinFuncBudget[T any](value T, budget Budget, join func(...error) error, opts ...Validate[T]) error {
+	errs := CollectBudget(value, budget, opts...)
+	if len(errs) == 0 {
+		return nil
+	}
+	return join(errs...)
+}
+
+// JoinBudget joins the errors collected within the budget using the errors.Join function when executing the validation functions.
+func JoinBudget[T any](value T, budget Budget, opts ...Validate[T]) error {
+	return JoinFuncBudget(value, budget, errors.Join, opts...)
+}
+
+// SliceEachBudget returns a validation function that checks whether each element in the slice satisfies the specified validation functions,
+// collecting the errors within the budget.
+func SliceEachBudget[S ~[]E, E any](budget Budget, opts ...Validate[E]) Validate[S] {
+	return func(s S) error {
+		c := &collector{budget: budget}
+		var errs []error
+		for i := range s {
+			if c.exhausted() {
+				break
+			}
+			if elemErrs := collect(c, s[i], opts); len(elemErrs) > 0 {
+				errs = append(errs, &FieldError{Field: Index(i), Err: errors.Join(elemErrs...)})
+			}
+		}
+		return errors.Join(c.summarize(errs)...)
+	}
+}
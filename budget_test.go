@@ -0,0 +1,72 @@
+package please
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSliceEachBudget(t *testing.T) {
+	tests := []struct {
+		name   string
+		budget Budget
+		input  []string
+		want   string
+	}{
+		{
+			name:   "exactly at the limit",
+			budget: Budget{Max: 2},
+			input:  []string{"a", "b", "ccc", "ddd"},
+			want:   "[0]: must contain at least 3 characters\n[1]: must contain at least 3 characters",
+		},
+		{
+			name:   "over the limit",
+			budget: Budget{Max: 2},
+			input:  []string{"a", "b", "ccc", "d"},
+			want:   "[0]: must contain at least 3 characters\n[1]: must contain at least 3 characters\nstopped after 2 errors",
+		},
+		{
+			name:   "counted",
+			budget: Budget{Max: 1, Count: true},
+			input:  []string{"a", "b", "c"},
+			want:   "[0]: must contain at least 3 characters\nand 2 more errors",
+		},
+		{
+			name:   "no limit",
+			budget: Budget{},
+			input:  []string{"ccc"},
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SliceEachBudget[[]string](tt.budget, StringMinLen(3))(tt.input)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectBudget(t *testing.T) {
+	fail := func(string) error { return errors.New("fail") }
+
+	errs := CollectBudget("x", Budget{Max: 2}, fail, fail)
+	if len(errs) != 2 {
+		t.Fatalf("got %d errors, want 2", len(errs))
+	}
+
+	errs = CollectBudget("x", Budget{Max: 1}, fail, fail, fail)
+	var budgetErr *BudgetError
+	if len(errs) != 2 || !errors.As(errs[1], &budgetErr) || !budgetErr.Stopped {
+		t.Fatalf("got %v, want one error and a stopped summary", errs)
+	}
+
+	errs = CollectBudget("x", Budget{FirstPerPath: true}, fail, fail)
+	if len(errs) != 1 {
+		t.Fatalf("got %d errors, want 1", len(errs))
+	}
+}
@@ -0,0 +1,182 @@
+package please
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Charset is an immutable set of characters built once and used by the charset validation functions.
+// ASCII characters are looked up in a bitset, other characters in the underlying strings, ranges and tables.
+// The zero value is an empty set.
+type Charset struct {
+	ascii     [2]uint64
+	contains  func(char rune) bool
+	desc      string
+	composite bool
+}
+
+// newCharset returns a charset with the specified membership function and description, precomputing the ASCII bitset.
+func newCharset(desc string, contains func(char rune) bool) Charset {
+	c := Charset{contains: contains, desc: desc}
+	for char := rune(0); char < utf8.RuneSelf; char++ {
+		if contains(char) {
+			c.ascii[char/64] |= 1 << (char % 64)
+		}
+	}
+	return c
+}
+
+// CharsetOf returns a charset of the characters in the string.
+func CharsetOf(chars string) Charset {
+	var runes []rune
+	for _, char := range chars {
+		if char >= utf8.RuneSelf {
+			runes = append(runes, char)
+		}
+	}
+	slices.Sort(runes)
+	runes = slices.Compact(runes)
+	return newCharset(strconv.Quote(chars), func(char rune) bool {
+		if char < utf8.RuneSelf {
+			return strings.ContainsRune(chars, char)
+		}
+		_, ok := slices.BinarySearch(runes, char)
+		return ok
+	})
+}
+
+// CharsetRange returns a charset of the characters from lo to hi inclusive, it panics if lo is greater than hi.
+func CharsetRange(lo, hi rune) Charset {
+	if lo > hi {
+		panic("please: invalid charset range " + strconv.QuoteRune(lo) + "-" + strconv.QuoteRune(hi))
+	}
+	return newCharset(strconv.QuoteRune(lo)+"-"+strconv.QuoteRune(hi), func(char rune) bool {
+		return char >= lo && char <= hi
+	})
+}
+
+// CharsetTable returns a charset of the characters in the unicode range table, the name is used in descriptions.
+func CharsetTable(name string, table *unicode.RangeTable) Charset {
+	return newCharset(name, func(char rune) bool {
+		return unicode.Is(table, char)
+	})
+}
+
+// Contains reports whether the character is in the charset.
+func (c Charset) Contains(char rune) bool {
+	if char >= 0 && char < utf8.RuneSelf {
+		return c.ascii[char/64]&(1<<(char%64)) != 0
+	}
+	return c.contains != nil && c.contains(char)
+}
+
+// ContainsAny reports whether any character of the string is in the charset.
+func (c Charset) ContainsAny(s string) bool {
+	for _, char := range s {
+		if c.Contains(char) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAll reports whether all characters of the string are in the charset.
+func (c Charset) ContainsAll(s string) bool {
+	for _, char := range s {
+		if !c.Contains(char) {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns a charset of the characters that are in the charset or in any of the other charsets.
+func (c Charset) Union(others ...Charset) Charset {
+	if len(others) == 0 {
+		return c
+	}
+	sets := append([]Charset{c}, others...)
+	desc := make([]string, 0, len(sets))
+	for _, set := range sets {
+		desc = append(desc, set.operand())
+	}
+	u := newCharset(strings.Join(desc, " or "), func(char rune) bool {
+		for _, set := range sets {
+			if set.Contains(char) {
+				return true
+			}
+		}
+		return false
+	})
+	u.composite = true
+	return u
+}
+
+// Difference returns a charset of the characters that are in the charset but not in the other charset.
+func (c Charset) Difference(other Charset) Charset {
+	d := newCharset(c.operand()+" except "+other.operand(), func(char rune) bool {
+		return c.Contains(char) && !other.Contains(char)
+	})
+	d.composite = true
+	return d
+}
+
+// operand returns the description of the charset as an operand of a set operation, parenthesized if it is composite.
+func (c Charset) operand() string {
+	if c.composite {
+		return "(" + c.String() + ")"
+	}
+	return c.String()
+}
+
+// String returns the human-readable description of the charset used in error messages.
+func (c Charset) String() string {
+	if c.desc == "" {
+		return "empty set"
+	}
+	return c.desc
+}
+
+// StringAllowCharset returns a validation function that checks whether the string contains only characters of the charset.
+func StringAllowCharset(c Charset) Validate[string] {
+	return func(s string) error {
+		if !c.ContainsAll(s) {
+			return errorf("must contain only allowed characters: %s", c)
+		}
+		return nil
+	}
+}
+
+// StringNotAllowCharset returns a validation function that checks whether the string does not contain characters of the charset.
+func StringNotAllowCharset(c Charset) Validate[string] {
+	return func(s string) error {
+		if c.ContainsAny(s) {
+			return errorf("must not contain disallowed characters: %s", c)
+		}
+		return nil
+	}
+}
+
+// StringContainsAnyCharset returns a validation function that checks whether the string contains at least one character of the charset.
+func StringContainsAnyCharset(c Charset) Validate[string] {
+	return func(s string) error {
+		if !c.ContainsAny(s) {
+			return errorf("must contain one of characters: %s", c)
+		}
+		return nil
+	}
+}
+
+// StringContainsAllCharsets returns a validation function that checks whether the string contains at least one character of each charset.
+func StringContainsAllCharsets(sets ...Charset) Validate[string] {
+	opts := make([]Validate[string], 0, len(sets))
+	for _, c := range sets {
+		opts = append(opts, StringContainsAnyCharset(c))
+	}
+	return func(s string) error {
+		return Join(s, opts...)
+	}
+}
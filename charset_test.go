@@ -0,0 +1,110 @@
+package please
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestCharsetContains(t *testing.T) {
+	tests := []struct {
+		name    string
+		charset Charset
+		in      string
+		out     string
+	}{
+		{"ascii string", CharsetOf("abc_"), "abc_", "dA-\x00\x7f"},
+		{"non-ascii string", CharsetOf("жё€😀"), "жё€😀", "зe$"},
+		{"range", CharsetRange('0', '9'), "0189", "/:a"},
+		{"non-ascii range", CharsetRange('а', 'я'), "аюя", "ёAa"},
+		{"table", CharsetTable("Greek", unicode.Greek), "αβΩ", "abж"},
+		{"zero value", Charset{}, "", "a ж"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, char := range tt.in {
+				if !tt.charset.Contains(char) {
+					t.Errorf("%q is not in %s", char, tt.charset)
+				}
+			}
+			for _, char := range tt.out {
+				if tt.charset.Contains(char) {
+					t.Errorf("%q is in %s", char, tt.charset)
+				}
+			}
+		})
+	}
+	if CharsetOf("a").Contains(-1) {
+		t.Error("negative rune is in the charset")
+	}
+}
+
+func TestCharsetAlgebra(t *testing.T) {
+	letters := CharsetOf("ab").Union(CharsetRange('0', '9'), CharsetOf("ж"))
+	for _, char := range "ab05ж" {
+		if !letters.Contains(char) {
+			t.Errorf("%q is not in the union", char)
+		}
+	}
+	if letters.Contains('c') {
+		t.Error("c is in the union")
+	}
+	diff := letters.Difference(CharsetOf("b5"))
+	for _, char := range "a0ж" {
+		if !diff.Contains(char) {
+			t.Errorf("%q is not in the difference", char)
+		}
+	}
+	for _, char := range "b5c" {
+		if diff.Contains(char) {
+			t.Errorf("%q is in the difference", char)
+		}
+	}
+	if !diff.ContainsAll("a0ж") || diff.ContainsAll("ab") || !diff.ContainsAny("xa") || diff.ContainsAny("b5") {
+		t.Error("unexpected ContainsAll or ContainsAny result")
+	}
+}
+
+func TestCharsetString(t *testing.T) {
+	tests := []struct {
+		charset Charset
+		want    string
+	}{
+		{CharsetOf("ab"), `"ab"`},
+		{CharsetRange('0', '9'), `'0'-'9'`},
+		{Charset{}, "empty set"},
+		{CharsetOf("ab").Union(CharsetRange('0', '9')), `"ab" or '0'-'9'`},
+		{CharsetOf("ab").Union(), `"ab"`},
+		{CharsetOf("ab").Difference(CharsetOf("b")).Union(CharsetRange('0', '9')), `("ab" except "b") or '0'-'9'`},
+		{CharsetOf("ab").Union(CharsetOf("c")).Difference(CharsetOf("b")), `("ab" or "c") except "b"`},
+		{CharsetOf("ab").Difference(CharsetOf("a").Union(CharsetOf("b"))), `"ab" except ("a" or "b")`},
+		{CharsetOf(" or ").Difference(CharsetOf("o")), `" or " except "o"`},
+		{tablesCharset([]*unicode.RangeTable{unicode.Latin, unicode.Greek}).Union(CharsetOf("_")), `(Latin, Greek) or "_"`},
+	}
+	for _, tt := range tests {
+		if got := tt.charset.String(); got != tt.want {
+			t.Errorf("got %s, want %s", got, tt.want)
+		}
+	}
+}
+
+func TestCharsetRangeReversed(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("reversed range did not panic")
+		}
+	}()
+	CharsetRange('z', 'a')
+}
+
+func TestCharsetRules(t *testing.T) {
+	digits := CharsetRange('0', '9')
+	if err := StringAllowCharset(digits)("12a"); err == nil || err.Error() != `must contain only allowed characters: '0'-'9'` {
+		t.Errorf("got %v", err)
+	}
+	if err := StringNotAllowCharset(digits)("a1"); err == nil {
+		t.Error("a1 passed StringNotAllowCharset")
+	}
+	if err := StringContainsAllCharsets(digits, CharsetOf("!?"))("abc1"); err == nil || err.Error() != `must contain one of characters: "!?"` {
+		t.Errorf("got %v", err)
+	}
+}
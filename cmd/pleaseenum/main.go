@@ -0,0 +1,283 @@
+// Command pleaseenum generates validation and text conversion code for enums declared as typed constants.
+//
+// For a named type with a block of constants, like
+//
+//	type Status string
+//
+//	const (
+//		StatusPending Status = "pending"
+//		StatusActive  Status = "active"
+//	)
+//
+// running "pleaseenum -type=Status" in the package directory generates status_enum.go with:
+//
+//   - StatusValues, the slice of all values in the declared order;
+//   - StatusEnum, the please.Enum of all values;
+//   - ValidateStatus, the membership validation function;
+//   - ParseStatus, which parses the text of a value and rejects unknown values;
+//   - String, MarshalText and UnmarshalText methods, which reject unknown values.
+//
+// The text of a value of a string type is the value itself, the text of a value of an integer type is the name
+// of the constant without the prefix specified by the -trimprefix flag.
+//
+// Typical usage is a go:generate directive next to the type declaration:
+//
+//	//go:generate go run github.com/zhassymov/please/cmd/pleaseenum -type=Status
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/constant"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	typeName   = flag.String("type", "", "name of the enum type; required")
+	output     = flag.String("output", "", "output file name; default <type>_enum.go in the package directory")
+	trimPrefix = flag.String("trimprefix", "", "prefix to trim from the constant names of integer enums")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("pleaseenum: ")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: pleaseenum -type=T [-output=file] [-trimprefix=prefix] [directory]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *typeName == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+	name := *output
+	if name == "" {
+		name = filepath.Join(dir, strings.ToLower(*typeName)+"_enum.go")
+	}
+	src, err := generate(dir, filepath.Base(name), *typeName, *trimPrefix)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(name, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// value is a constant of the enum type.
+type value struct {
+	name string
+	text string
+}
+
+// generate returns the generated source code for the enum type declared in the package directory.
+func generate(dir, output, typ, trim string) ([]byte, error) {
+	fset := token.NewFileSet()
+	name, files, err := parsePackage(fset, dir, output)
+	if err != nil {
+		return nil, err
+	}
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(error) {}, // constants of the enum type are evaluated regardless of unrelated errors
+	}
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	pkg, _ := conf.Check(name, fset, files, info)
+
+	obj, ok := pkg.Scope().Lookup(typ).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("type %s is not declared in %s", typ, dir)
+	}
+	basic, ok := obj.Type().Underlying().(*types.Basic)
+	if !ok || basic.Info()&(types.IsString|types.IsInteger) == 0 {
+		return nil, fmt.Errorf("type %s must have a string or integer underlying type", typ)
+	}
+	values, err := constants(files, info, obj.Type(), basic.Info()&types.IsString != 0, trim)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no constants of type %s", typ)
+	}
+
+	var b bytes.Buffer
+	render(&b, pkg.Name(), typ, basic, values)
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w", err)
+	}
+	return src, nil
+}
+
+// parsePackage parses the Go files of the package directory that match the build constraints of the current platform,
+// except the test files and the output file, and returns them with the package name.
+func parsePackage(fset *token.FileSet, dir, output string) (string, []*ast.File, error) {
+	pkg, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return "", nil, err
+	}
+	var files []*ast.File
+	for _, name := range pkg.GoFiles {
+		if name == output {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return "", nil, err
+		}
+		files = append(files, f)
+	}
+	if len(files) == 0 {
+		return "", nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	return pkg.Name, files, nil
+}
+
+// constants returns the constants of the enum type in the declared order, constants with repeated values are skipped.
+func constants(files []*ast.File, info *types.Info, typ types.Type, isString bool, trim string) ([]value, error) {
+	var values []value
+	seen := make(map[string]bool)
+	texts := make(map[string]string)
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				for _, ident := range spec.(*ast.ValueSpec).Names {
+					c, ok := info.Defs[ident].(*types.Const)
+					if !ok || !types.Identical(c.Type(), typ) {
+						continue
+					}
+					if c.Val().Kind() == constant.Unknown {
+						return nil, fmt.Errorf("cannot evaluate constant %s", c.Name())
+					}
+					key := c.Val().ExactString()
+					if seen[key] {
+						continue
+					}
+					seen[key] = true
+					text := strings.TrimPrefix(c.Name(), trim)
+					if isString {
+						text = constant.StringVal(c.Val())
+					}
+					if other, ok := texts[text]; ok {
+						return nil, fmt.Errorf("constants %s and %s have the same text %q", other, c.Name(), text)
+					}
+					texts[text] = c.Name()
+					values = append(values, value{name: c.Name(), text: text})
+				}
+			}
+		}
+	}
+	if len(values) > 0 && values[0].text == "" && !isString {
+		return nil, errors.New("constant names must not be equal to the trimmed prefix")
+	}
+	return values, nil
+}
+
+// render writes the generated source code for the enum type.
+func render(b *bytes.Buffer, pkg, typ string, underlying *types.Basic, values []value) {
+	isString := underlying.Info()&types.IsString != 0
+	lower := strings.ToLower(typ[:1]) + typ[1:]
+	p := func(format string, args ...any) {
+		fmt.Fprintf(b, format+"\n", args...)
+	}
+	p("// Code generated by pleaseenum -type=%s; DO NOT EDIT.", typ)
+	p("")
+	p("package %s", pkg)
+	p("")
+	if isString {
+		p("import %q", "github.com/zhassymov/please")
+	} else {
+		p("import (")
+		p("\t%q", "fmt")
+		p("")
+		p("\t%q", "github.com/zhassymov/please")
+		p(")")
+	}
+	p("")
+	p("// %sValues is the list of all %s values in the declared order.", typ, typ)
+	p("var %sValues = []%s{", typ, typ)
+	for _, v := range values {
+		p("\t%s,", v.name)
+	}
+	p("}")
+	p("")
+	p("// %sEnum is the enum of all %s values.", typ, typ)
+	p("var %sEnum = please.NewEnum(%sValues...)", typ, typ)
+	p("")
+	p("// %sTexts is the list of the texts of all %s values in the declared order.", lower, typ)
+	p("var %sTexts = []string{", lower)
+	for _, v := range values {
+		p("\t%s,", strconv.Quote(v.text))
+	}
+	p("}")
+	p("")
+	p("// Validate%s returns a validation function that checks whether the value is a declared %s value.", typ, typ)
+	p("func Validate%s() please.Validate[%s] {", typ, typ)
+	p("\treturn %sEnum.OneOf()", typ)
+	p("}")
+	p("")
+	p("// Parse%s returns the %s value with the specified text, unknown texts are rejected.", typ, typ)
+	p("func Parse%s(s string) (%s, error) {", typ, typ)
+	p("\tswitch s {")
+	for _, v := range values {
+		p("\tcase %s:", strconv.Quote(v.text))
+		p("\t\treturn %s, nil", v.name)
+	}
+	p("\t}")
+	p("\tvar zero %s", typ)
+	p("\treturn zero, please.OneOf(%sTexts...)(s)", lower)
+	p("}")
+	p("")
+	p("// String returns the text of the value.")
+	p("func (v %s) String() string {", typ)
+	p("\tswitch v {")
+	for _, v := range values {
+		p("\tcase %s:", v.name)
+		p("\t\treturn %s", strconv.Quote(v.text))
+	}
+	p("\t}")
+	if isString {
+		p("\treturn string(v)")
+	} else {
+		p("\treturn fmt.Sprintf(\"%s(%%d)\", %s(v))", typ, underlying.Name())
+	}
+	p("}")
+	p("")
+	p("// MarshalText returns the text of the value, unknown values are rejected.")
+	p("func (v %s) MarshalText() ([]byte, error) {", typ)
+	p("\tif err := Validate%s()(v); err != nil {", typ)
+	p("\t\treturn nil, err")
+	p("\t}")
+	p("\treturn []byte(v.String()), nil")
+	p("}")
+	p("")
+	p("// UnmarshalText parses the text of the value, unknown texts are rejected.")
+	p("func (v *%s) UnmarshalText(text []byte) error {", typ)
+	p("\tparsed, err := Parse%s(string(text))", typ)
+	p("\tif err != nil {")
+	p("\t\treturn err")
+	p("\t}")
+	p("\t*v = parsed")
+	p("\treturn nil")
+	p("}")
+}
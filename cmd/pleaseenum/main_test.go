@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+var goldenTests = []struct {
+	dir  string
+	typ  string
+	trim string
+}{
+	{dir: "status", typ: "Status"},
+	{dir: "color", typ: "Color", trim: "Color"},
+	{dir: "level", typ: "Level", trim: "Level"},
+}
+
+func TestGenerateGolden(t *testing.T) {
+	for _, tt := range goldenTests {
+		t.Run(tt.dir, func(t *testing.T) {
+			dir := filepath.Join("testdata", tt.dir)
+			got, err := generate(dir, "enum.go", tt.typ, tt.trim)
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden := filepath.Join("testdata", tt.dir+".golden")
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("generated code differs from %s, run go test -update to review the changes:\n%s", golden, got)
+			}
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  string
+		trim string
+	}{
+		{name: "unknown type", typ: "Missing"},
+		{name: "trim whole name", typ: "Color", trim: "ColorRed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := generate(filepath.Join("testdata", "color"), "enum.go", tt.typ, tt.trim); err == nil {
+				t.Error("got no error")
+			}
+		})
+	}
+}
+
+// roundTrip is the program that checks the generated code of both golden packages.
+const roundTrip = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"example.com/enums/color"
+	"example.com/enums/level"
+	"example.com/enums/status"
+)
+
+func main() {
+	var s struct {
+		Status status.Status
+		Color  color.Color
+	}
+	if err := json.Unmarshal([]byte(` + "`" + `{"Status":"active","Color":"Blue"}` + "`" + `), &s); err != nil {
+		fail(err)
+	}
+	out, err := json.Marshal(s)
+	if err != nil {
+		fail(err)
+	}
+	if string(out) != ` + "`" + `{"Status":"active","Color":"Blue"}` + "`" + ` {
+		fail(fmt.Errorf("round trip: %s", out))
+	}
+	if err := json.Unmarshal([]byte(` + "`" + `{"Status":"activ"}` + "`" + `), &s); err == nil {
+		fail(fmt.Errorf("unknown status accepted"))
+	}
+	if _, err := json.Marshal(color.Color(9)); err == nil {
+		fail(fmt.Errorf("unknown color marshaled"))
+	}
+	if _, err := level.ParseLevel("Trace"); err == nil {
+		fail(fmt.Errorf("constant of an excluded file generated"))
+	}
+	if got := color.Color(9).String(); got != "Color(9)" {
+		fail(fmt.Errorf("got %s", got))
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
+`
+
+func TestGeneratedCodeCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the generated code with the go command")
+	}
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	root, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmp := t.TempDir()
+	write := func(name string, data []byte) {
+		t.Helper()
+		path := filepath.Join(tmp, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("go.mod", []byte("module example.com/enums\n\ngo 1.22\n\nrequire github.com/zhassymov/please v0.0.0\n\nreplace github.com/zhassymov/please => "+root+"\n"))
+	sum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	write("go.sum", sum)
+	write("main.go", []byte(roundTrip))
+	for _, tt := range goldenTests {
+		entries, err := os.ReadDir(filepath.Join("testdata", tt.dir))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, entry := range entries {
+			src, err := os.ReadFile(filepath.Join("testdata", tt.dir, entry.Name()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			write(filepath.Join(tt.dir, entry.Name()), src)
+		}
+		golden, err := os.ReadFile(filepath.Join("testdata", tt.dir+".golden"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		write(filepath.Join(tt.dir, "enum.go"), golden)
+	}
+	cmd := exec.Command(gobin, "run", "-mod=mod", ".")
+	cmd.Dir = tmp
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go run: %v\n%s", err, out)
+	}
+}
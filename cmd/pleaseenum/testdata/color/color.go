@@ -0,0 +1,15 @@
+package color
+
+// Color is a color of the palette.
+type Color uint8
+
+const (
+	ColorRed Color = iota + 1
+	ColorGreen
+	ColorBlue
+	// ColorPrimary repeats the value of ColorRed and is skipped.
+	ColorPrimary = ColorRed
+)
+
+// unrelated constants of other types are ignored.
+const maxColors = 8
@@ -0,0 +1,6 @@
+//go:build ignore
+
+// This helper sorts before the package files and must not be parsed with them.
+package main
+
+func main() {}
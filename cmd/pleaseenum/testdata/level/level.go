@@ -0,0 +1,10 @@
+package level
+
+// Level is a logging level.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelError
+)
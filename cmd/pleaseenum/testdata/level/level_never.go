@@ -0,0 +1,6 @@
+//go:build never
+
+package level
+
+// LevelTrace is excluded by the build constraint and must not be generated.
+const LevelTrace Level = -1
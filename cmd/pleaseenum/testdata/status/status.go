@@ -0,0 +1,12 @@
+package status
+
+// Status is the status of an order.
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusActive   Status = "active"
+	StatusArchived Status = "archived"
+	// StatusDefault repeats the value of StatusPending and is skipped.
+	StatusDefault = StatusPending
+)
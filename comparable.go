@@ -1,6 +1,11 @@
 package please
 
-import "fmt"
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
 
 // Empty returns a validation function that checks whether the value is empty.
 func Empty[T comparable]() Validate[T] {
@@ -9,7 +14,7 @@ func Empty[T comparable]() Validate[T] {
 		if value == empty {
 			return nil
 		}
-		return fmt.Errorf("%v must be empty", value)
+		return errorf("%v must be empty", value)
 	}
 }
 
@@ -20,7 +25,7 @@ func NotEmpty[T comparable]() Validate[T] {
 		if value != empty {
 			return nil
 		}
-		return fmt.Errorf("%v must not be empty", value)
+		return errorf("%v must not be empty", value)
 	}
 }
 
@@ -28,7 +33,7 @@ func NotEmpty[T comparable]() Validate[T] {
 func Equal[T comparable](target T) Validate[T] {
 	return func(value T) error {
 		if value != target {
-			return fmt.Errorf("%v must be equal to %v", value, target)
+			return errorf("%v must be equal to %v", value, target)
 		}
 		return nil
 	}
@@ -38,37 +43,25 @@ func Equal[T comparable](target T) Validate[T] {
 func NotEqual[T comparable](target T) Validate[T] {
 	return func(value T) error {
 		if value == target {
-			return fmt.Errorf("%v must not be equal to %v", value, target)
+			return errorf("%v must not be equal to %v", value, target)
 		}
 		return nil
 	}
 }
 
 // OneOf returns a validation function that checks whether the value exists in the enum slice.
+// The error suggests the enum values closest to the invalid value if the values are strings.
 func OneOf[T comparable](enum ...T) Validate[T] {
-	return func(value T) error {
-		for _, e := range enum {
-			if value == e {
-				return nil
-			}
-		}
-		return fmt.Errorf("%v must be one of %v", value, enum)
-	}
+	return NewEnum(enum...).OneOf()
 }
 
 // NotOneOf returns a validation function that checks whether the value does not exist in the enum slice.
 func NotOneOf[T comparable](enum ...T) Validate[T] {
-	return func(value T) error {
-		for _, e := range enum {
-			if value == e {
-				return fmt.Errorf("%v must not be one of %v", value, enum)
-			}
-		}
-		return nil
-	}
+	return NewEnum(enum...).NotOneOf()
 }
 
-// keys returns a slice of keys from the map.
+// keys returns a slice of keys from the map ordered by value if they are of numeric or string kinds, like [1 2 10],
+// and by their default format otherwise.
 func keys[T comparable](m map[T]bool) []T {
 	if len(m) == 0 {
 		return nil
@@ -77,25 +70,52 @@ func keys[T comparable](m map[T]bool) []T {
 	for k := range m {
 		s = append(s, k)
 	}
+	slices.SortFunc(s, compareKeys[T])
 	return s
 }
 
+// compareKeys compares the values by value if they are of numeric or string kinds, and by their default format otherwise.
+func compareKeys[T comparable](a, b T) int {
+	x, y := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !x.IsValid() || !y.IsValid() || x.Type() != y.Type() {
+		return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	}
+	switch x.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(x.Int(), y.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(x.Uint(), y.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(x.Float(), y.Float())
+	case reflect.String:
+		return cmp.Compare(x.String(), y.String())
+	}
+	return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
 // OneIn returns a validation function that checks whether the value exists in the enum map keys.
+// The error suggests the enum keys closest to the invalid value if the keys are strings.
 func OneIn[T comparable](enum map[T]bool) Validate[T] {
+	strs := isString[T]()
 	return func(value T) error {
 		if _, ok := enum[value]; ok {
 			return nil
 		}
-		return fmt.Errorf("%v must be one in %v", value, keys(enum))
+		k := keys(enum)
+		err := errorf("%v must be one in %v", value, k)
+		if !strs {
+			return err
+		}
+		return suggest(err, Suggest(fmt.Sprint(value), formatAll(k)...))
 	}
 }
 
 // NotOneIn returns a validation function that checks whether the value does not exist in the enum map keys.
 func NotOneIn[T comparable](enum map[T]bool) Validate[T] {
 	return func(value T) error {
-		if _, ok := enum[value]; ok {
+		if _, ok := enum[value]; !ok {
 			return nil
 		}
-		return fmt.Errorf("%v must not be one in %v", value, keys(enum))
+		return errorf("%v must not be one in %v", value, keys(enum))
 	}
 }
@@ -0,0 +1,107 @@
+package please
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Concurrency configures the concurrent validation of collection elements.
+type Concurrency struct {
+	// Context cancels the validation of the remaining elements, nil means context.Background().
+	Context context.Context
+	// Workers is the maximum number of elements validated concurrently, zero means runtime.GOMAXPROCS(0).
+	Workers int
+	// Abort stops the validation after the first failed element and returns only its error.
+	Abort bool
+	// Budget limits the collected errors like SliceEachBudget, the kept errors are those of the lowest element indexes.
+	Budget Budget
+}
+
+// SliceEachConcurrent returns a validation function that concurrently checks whether each element in the slice satisfies the specified validation functions.
+// The errors are ordered by the element index regardless of the order in which the elements were validated.
+func SliceEachConcurrent[S ~[]E, E any](c Concurrency, opts ...Validate[E]) Validate[S] {
+	return func(s S) error {
+		if len(s) == 0 {
+			return nil
+		}
+		parent := c.Context
+		if parent == nil {
+			parent = context.Background()
+		}
+		ctx, cancel := context.WithCancel(parent)
+		defer cancel()
+
+		workers := c.Workers
+		if workers <= 0 {
+			workers = runtime.GOMAXPROCS(0)
+		}
+		workers = min(workers, len(s))
+
+		errs := make([][]error, len(s))
+		var next, total atomic.Int64
+		var failed atomic.Bool
+		var wg sync.WaitGroup
+		wg.Add(workers)
+		for range workers {
+			go func() {
+				defer wg.Done()
+				for ctx.Err() == nil {
+					i := int(next.Add(1) - 1)
+					if i >= len(s) {
+						return
+					}
+					elemErrs := collect(&collector{budget: Budget{FirstPerPath: c.Budget.FirstPerPath}}, s[i], opts)
+					if len(elemErrs) == 0 {
+						continue
+					}
+					errs[i] = elemErrs
+					if c.Abort {
+						failed.Store(true)
+						cancel()
+					}
+					// The claimed elements are finished before the workers stop, so the lowest indexes are all validated.
+					if c.Budget.Max > 0 && !c.Budget.Count && total.Add(int64(len(elemErrs))) > int64(c.Budget.Max) {
+						cancel()
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		// Elements are claimed in index order, so all elements before the first failed one are validated.
+		if c.Abort && failed.Load() {
+			for i, elemErrs := range errs {
+				if len(elemErrs) > 0 {
+					return &FieldError{Field: Index(i), Err: errors.Join(elemErrs...)}
+				}
+			}
+		}
+		budget := &collector{budget: c.Budget}
+		var result []error
+		for i, elemErrs := range errs {
+			var kept []error
+			for _, err := range elemErrs {
+				if budget.keep() {
+					kept = append(kept, err)
+				} else if budget.exhausted() {
+					break
+				}
+			}
+			if len(kept) > 0 {
+				result = append(result, &FieldError{Field: Index(i), Err: errors.Join(kept...)})
+			}
+			if budget.exhausted() {
+				break
+			}
+		}
+		result = budget.summarize(result)
+		// The context error is reported only if it caused some elements to be skipped.
+		if err := parent.Err(); err != nil && int(next.Load()) < len(s) && !budget.exhausted() {
+			result = append(result, context.Cause(parent))
+		}
+		return errors.Join(result...)
+	}
+}
@@ -0,0 +1,87 @@
+package please
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSliceEachConcurrentOrder(t *testing.T) {
+	input := []string{"a", "ok!", "b", "fine", "c"}
+	err := SliceEachConcurrent[[]string](Concurrency{Workers: 3}, StringMinLen(3))(input)
+	want := "[0]: must contain at least 3 characters\n[2]: must contain at least 3 characters\n[4]: must contain at least 3 characters"
+	if err == nil || err.Error() != want {
+		t.Errorf("got %v, want %q", err, want)
+	}
+}
+
+func TestSliceEachConcurrentAbort(t *testing.T) {
+	input := []string{"ok!", "a", "b", "c"}
+	err := SliceEachConcurrent[[]string](Concurrency{Workers: 1, Abort: true}, StringMinLen(3))(input)
+	if err == nil || err.Error() != "[1]: must contain at least 3 characters" {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestSliceEachConcurrentBudget(t *testing.T) {
+	tests := []struct {
+		name   string
+		budget Budget
+		input  []string
+		want   string
+	}{
+		{
+			name:   "exactly at the limit",
+			budget: Budget{Max: 2},
+			input:  []string{"a", "b", "ccc", "ddd"},
+			want:   "[0]: must contain at least 3 characters\n[1]: must contain at least 3 characters",
+		},
+		{
+			name:   "over the limit",
+			budget: Budget{Max: 1},
+			input:  []string{"ccc", "a", "b", "c"},
+			want:   "[1]: must contain at least 3 characters\nstopped after 1 error",
+		},
+		{
+			name:   "counted",
+			budget: Budget{Max: 1, Count: true},
+			input:  []string{"a", "b", "c"},
+			want:   "[0]: must contain at least 3 characters\nand 2 more errors",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SliceEachConcurrent[[]string](Concurrency{Workers: 2, Budget: tt.budget}, StringMinLen(3))(tt.input)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSliceEachConcurrentContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	rule := func(string) error {
+		cancel()
+		return nil
+	}
+	err := SliceEachConcurrent[[]string](Concurrency{Context: ctx, Workers: 1}, rule)([]string{"a", "b", "c"})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	err = SliceEachConcurrent[[]string](Concurrency{Context: ctx, Workers: 1}, func(s string) error {
+		if s == "c" {
+			cancel()
+		}
+		return nil
+	})([]string{"a", "b", "c"})
+	if err != nil {
+		t.Errorf("got %v, want nil when every element was validated", err)
+	}
+}
@@ -0,0 +1,78 @@
+package please
+
+import (
+	_ "embed"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// confusablesData is the embedded Unicode 13.0.0 confusables.txt file without the comments of the mappings.
+//
+//go:generate go run gen_confusables.go
+//go:embed confusables.txt
+var confusablesData string
+
+// confusables returns the confusable mappings from the source character to its prototype.
+var confusables = sync.OnceValue(func() map[rune]string {
+	m := make(map[rune]string)
+	for _, line := range strings.Split(confusablesData, "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			continue
+		}
+		source, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 16, 32)
+		if err != nil {
+			continue
+		}
+		var target strings.Builder
+		for _, code := range strings.Fields(fields[1]) {
+			char, err := strconv.ParseUint(code, 16, 32)
+			if err != nil {
+				continue
+			}
+			target.WriteRune(rune(char))
+		}
+		m[rune(source)] = target.String()
+	}
+	return m
+})
+
+// Skeleton returns the skeleton of the string as defined by UTS #39: visually confusable strings have equal skeletons.
+// The skeleton is meant for comparison only and must not be displayed or stored as a normalized value.
+func Skeleton(s string) string {
+	m := confusables()
+	var b strings.Builder
+	for _, char := range norm.NFD.String(s) {
+		if prototype, ok := m[char]; ok {
+			b.WriteString(prototype)
+			continue
+		}
+		b.WriteRune(char)
+	}
+	return norm.NFD.String(b.String())
+}
+
+// Confusable reports whether the strings are visually confusable, that is whether their skeletons are equal.
+func Confusable(x, y string) bool {
+	return Skeleton(x) == Skeleton(y)
+}
+
+// StringNotConfusable returns a validation function that checks whether the string is not visually confusable
+// with any of the reserved strings, like "аdmin" with Cyrillic "а" and "admin". Compatibility variants like fullwidth forms
+// are not confusables, combine the rule with StringNFKC to reject them.
+func StringNotConfusable(reserved ...string) Validate[string] {
+	skeletons := make(map[string]string, len(reserved))
+	for _, r := range reserved {
+		skeletons[Skeleton(r)] = r
+	}
+	return func(s string) error {
+		if r, ok := skeletons[Skeleton(s)]; ok {
+			return errorf("must not be confusable with %q", r)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,47 @@
+package please
+
+import "testing"
+
+func TestSkeleton(t *testing.T) {
+	tests := []struct {
+		x, y string
+	}{
+		{"paypal", "pаypаl"},   // Cyrillic а
+		{"Dave", "Ꭰave"},       // Cherokee Ꭰ
+		{"support", "ꜱupport"}, // small capital ꜱ
+		{"l1I", "lll"},
+		{"scope", "𝐬𝐜𝐨𝐩𝐞"}, // mathematical bold
+	}
+	for _, tt := range tests {
+		if Skeleton(tt.x) != Skeleton(tt.y) {
+			t.Errorf("Skeleton(%q) = %q, Skeleton(%q) = %q, want equal", tt.x, Skeleton(tt.x), tt.y, Skeleton(tt.y))
+		}
+	}
+}
+
+func TestConfusable(t *testing.T) {
+	if !Confusable("admin", "аdmin") {
+		t.Error("admin with Cyrillic а is not confusable with admin")
+	}
+	if Confusable("admin", "admins") {
+		t.Error("admin is confusable with admins")
+	}
+	if Confusable("hello", "world") {
+		t.Error("hello is confusable with world")
+	}
+}
+
+func TestStringNotConfusable(t *testing.T) {
+	validate := StringNotConfusable("admin", "support")
+	for _, s := range []string{"admin", "аdmin", "ꜱupport"} {
+		if err := validate(s); err == nil {
+			t.Errorf("%q passed the reserved names", s)
+		}
+	}
+	if err := validate("alice"); err != nil {
+		t.Errorf("got %v", err)
+	}
+	if err := validate("аdmin"); err == nil || err.Error() != `must not be confusable with "admin"` {
+		t.Errorf("got %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package please
+
+import "unsafe"
+
+// integerRange returns the minimum and maximum values of the integer type.
+func integerRange[T Integer]() (T, T) {
+	var zero T
+	if ^zero > 0 {
+		return zero, ^zero
+	}
+	bits := unsafe.Sizeof(zero) * 8
+	maximum := T(1)<<(bits-1) - 1
+	return ^maximum, maximum
+}
+
+// fits reports whether the integer value converts to the target integer type without truncation or sign change.
+func fits[To, From Integer](value From) bool {
+	converted := To(value)
+	return From(converted) == value && (value < 0) == (converted < 0)
+}
+
+// FitsIn returns a validation function that checks whether the integer value fits the range of the target integer type,
+// like FitsIn[int32, int64]() before converting the value for a protocol field.
+func FitsIn[To, From Integer]() Validate[From] {
+	return func(value From) error {
+		if !fits[To](value) {
+			lo, hi := integerRange[To]()
+			return errorf("%v must fit in %T [%v, %v]", value, lo, lo, hi)
+		}
+		return nil
+	}
+}
+
+// Convert returns the integer value converted to the target integer type,
+// or an error if the value does not fit the range of the type.
+func Convert[To, From Integer](value From) (To, error) {
+	if err := FitsIn[To, From]()(value); err != nil {
+		return 0, err
+	}
+	return To(value), nil
+}
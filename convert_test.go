@@ -0,0 +1,91 @@
+package please
+
+import (
+	"math"
+	"testing"
+)
+
+type testCode int16
+
+func TestIntegerRange(t *testing.T) {
+	check := func(name string, lo, hi, wantLo, wantHi any) {
+		t.Helper()
+		if lo != wantLo || hi != wantHi {
+			t.Errorf("%s: got [%v, %v], want [%v, %v]", name, lo, hi, wantLo, wantHi)
+		}
+	}
+	lo8, hi8 := integerRange[int8]()
+	check("int8", lo8, hi8, int8(math.MinInt8), int8(math.MaxInt8))
+	lo16, hi16 := integerRange[int16]()
+	check("int16", lo16, hi16, int16(math.MinInt16), int16(math.MaxInt16))
+	lo32, hi32 := integerRange[int32]()
+	check("int32", lo32, hi32, int32(math.MinInt32), int32(math.MaxInt32))
+	lo64, hi64 := integerRange[int64]()
+	check("int64", lo64, hi64, int64(math.MinInt64), int64(math.MaxInt64))
+	lo, hi := integerRange[int]()
+	check("int", lo, hi, int(math.MinInt), int(math.MaxInt))
+	loCode, hiCode := integerRange[testCode]()
+	check("named", loCode, hiCode, testCode(math.MinInt16), testCode(math.MaxInt16))
+	loU8, hiU8 := integerRange[uint8]()
+	check("uint8", loU8, hiU8, uint8(0), uint8(math.MaxUint8))
+	loU64, hiU64 := integerRange[uint64]()
+	check("uint64", loU64, hiU64, uint64(0), uint64(math.MaxUint64))
+}
+
+func TestFits(t *testing.T) {
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"int64 in int32", fits[int32](int64(math.MaxInt32)), true},
+		{"int64 above int32", fits[int32](int64(math.MaxInt32) + 1), false},
+		{"int64 below int32", fits[int32](int64(math.MinInt32) - 1), false},
+		{"negative in int8", fits[int8](int64(-128)), true},
+		{"negative in uint8", fits[uint8](int64(-1)), false},
+		{"negative in uint64", fits[uint64](int8(-1)), false},
+		{"uint8 in int8", fits[int8](uint8(127)), true},
+		{"uint8 above int8", fits[int8](uint8(128)), false},
+		{"uint32 in int32", fits[int32](uint32(math.MaxInt32)), true},
+		{"uint32 above int32", fits[int32](uint32(math.MaxUint32)), false},
+		{"uint64 above int64", fits[int64](uint64(math.MaxInt64) + 1), false},
+		{"uint64 max in int64", fits[int64](uint64(math.MaxUint64)), false},
+		{"uint64 in int", fits[int](uint64(math.MaxInt)), true},
+		{"uint64 in uint8", fits[uint8](uint64(256)), false},
+		{"same type", fits[int16](int16(math.MinInt16)), true},
+		{"named type", fits[testCode](int(math.MaxInt16) + 1), false},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	v, err := Convert[int8](int64(-5))
+	if err != nil || v != -5 {
+		t.Errorf("got %v, %v, want -5, nil", v, err)
+	}
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"above", FitsIn[int8, int]()(200), "200 must fit in int8 [-128, 127]"},
+		{"negative unsigned", FitsIn[uint16, int32]()(-1), "-1 must fit in uint16 [0, 65535]"},
+		{"unsigned above signed", FitsIn[int64, uint64]()(math.MaxUint64), "18446744073709551615 must fit in int64 [-9223372036854775808, 9223372036854775807]"},
+		{"named", FitsIn[testCode, int]()(-40000), "-40000 must fit in please.testCode [-32768, 32767]"},
+		{"fits", FitsIn[uint8, int]()(255), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorString(tt.err); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if _, err := Convert[uint32](int64(-1)); err == nil {
+		t.Error("negative value converted to uint32")
+	}
+}
@@ -0,0 +1,171 @@
+package please
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// Precision describes the maximum numbers of digits of a decimal number, like Precision{Digits: 12, Scale: 2} for SQL NUMERIC(12, 2).
+type Precision struct {
+	// Digits is the maximum number of digits, zero means no limit. The integer part may have at most Digits - Scale digits.
+	Digits int
+	// Scale is the maximum number of fractional digits.
+	Scale int
+	// Exponent allows the exponent notation in decimal strings, like "1.5e3".
+	Exponent bool
+}
+
+// check returns an error if the exact decimal number does not fit the precision.
+func (p Precision) check(r *big.Rat) error {
+	// The number has n fractional digits if the denominator divides 10^n.
+	pow, ten, rem := big.NewInt(1), big.NewInt(10), new(big.Int)
+	for scale := 0; rem.Rem(pow, r.Denom()).Sign() != 0; scale++ {
+		if scale >= p.Scale {
+			return errorf("must have at most %d fractional digits", p.Scale)
+		}
+		pow.Mul(pow, ten)
+	}
+	if p.Digits <= 0 {
+		return nil
+	}
+	integer := new(big.Int).Quo(r.Num(), r.Denom())
+	digits := 0
+	if integer.Sign() != 0 {
+		digits = len(integer.Abs(integer).String())
+	}
+	if digits > p.Digits-p.Scale {
+		return errorf("must have at most %d integer digits", p.Digits-p.Scale)
+	}
+	return nil
+}
+
+// maxDecimalExponent is the maximum magnitude of the exponent of a decimal string,
+// it keeps the parsed numbers small enough to validate short inputs like "1e1000000" in constant time.
+const maxDecimalExponent = 1000
+
+// parseDecimal parses the decimal string with an optional sign, fractional part and, if allowed, exponent.
+func parseDecimal(s string, exponent bool) (*big.Rat, error) {
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	digits := 0
+	for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+		digits++
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+		fraction := 0
+		for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+			fraction++
+		}
+		if fraction == 0 {
+			return nil, errors.New("must be a decimal number")
+		}
+		digits += fraction
+	}
+	if digits == 0 {
+		return nil, errors.New("must be a decimal number")
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		if !exponent {
+			return nil, errors.New("must not use exponent notation")
+		}
+		i++
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			i++
+		}
+		start, value := i, 0
+		for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+			if value = value*10 + int(s[i]-'0'); value > maxDecimalExponent {
+				return nil, errorf("must have an exponent of at most %d in magnitude", maxDecimalExponent)
+			}
+		}
+		if i == start {
+			return nil, errors.New("must be a decimal number")
+		}
+	}
+	if i != len(s) {
+		return nil, errors.New("must be a decimal number")
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, errors.New("must be a decimal number")
+	}
+	return r, nil
+}
+
+// StringPrecision returns a validation function that checks whether the string is a decimal number that fits the precision.
+// The check is exact, the string is never converted to a floating-point number.
+func StringPrecision(p Precision) Validate[string] {
+	return func(s string) error {
+		r, err := parseDecimal(s, p.Exponent)
+		if err != nil {
+			return err
+		}
+		return p.check(r)
+	}
+}
+
+// FloatPrecision returns a validation function that checks whether the shortest decimal representation of the floating-point value
+// fits the precision, like 0.1 fits the scale 1 although its binary value is not exactly 0.1.
+func FloatPrecision(p Precision) Validate[float64] {
+	return func(f float64) error {
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return errorf("%v must be finite", f)
+		}
+		r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+		return p.check(r)
+	}
+}
+
+// RatPrecision returns a validation function that checks whether the rational number is a decimal number that fits the precision.
+func RatPrecision(p Precision) Validate[*big.Rat] {
+	return func(r *big.Rat) error {
+		if r == nil {
+			return errors.New("must not be nil")
+		}
+		return p.check(r)
+	}
+}
+
+// StringDecimalBetween returns a validation function that checks whether the string is a decimal number between the decimal bounds,
+// comparing the numbers exactly without floating-point rounding. The exponent allows the exponent notation in the string, like "1.5e3".
+func StringDecimalBetween(x, y string, exponent bool) Validate[string] {
+	lo, errX := parseDecimal(x, true)
+	hi, errY := parseDecimal(y, true)
+	if lo != nil && hi != nil && lo.Cmp(hi) > 0 {
+		lo, hi, x, y = hi, lo, y, x
+	}
+	return func(s string) error {
+		if errX != nil || errY != nil {
+			return errorf("invalid decimal bounds %q and %q", x, y)
+		}
+		r, err := parseDecimal(s, exponent)
+		if err != nil {
+			return err
+		}
+		if r.Cmp(lo) < 0 || r.Cmp(hi) > 0 {
+			return errorf("%s must be between %s and %s", s, x, y)
+		}
+		return nil
+	}
+}
+
+// RatBetween returns a validation function that checks whether the rational number is between the bounds.
+func RatBetween(x, y *big.Rat) Validate[*big.Rat] {
+	if x.Cmp(y) > 0 {
+		x, y = y, x
+	}
+	return func(r *big.Rat) error {
+		if r == nil {
+			return errors.New("must not be nil")
+		}
+		if r.Cmp(x) < 0 || r.Cmp(y) > 0 {
+			return errorf("%s must be between %s and %s", r.RatString(), x.RatString(), y.RatString())
+		}
+		return nil
+	}
+}
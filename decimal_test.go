@@ -0,0 +1,100 @@
+package please
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestPrecisionCheck(t *testing.T) {
+	tests := []struct {
+		name      string
+		precision Precision
+		value     *big.Rat
+		want      string
+	}{
+		{"integer", Precision{Digits: 5, Scale: 2}, big.NewRat(123, 1), ""},
+		{"integer digits limit", Precision{Digits: 5, Scale: 2}, big.NewRat(1234, 1), "must have at most 3 integer digits"},
+		{"negative integer digits", Precision{Digits: 5, Scale: 2}, big.NewRat(-999, 1), ""},
+		{"zero has no integer digits", Precision{Digits: 2, Scale: 2}, big.NewRat(1, 100), ""},
+		{"no digits limit", Precision{Scale: 0}, big.NewRat(123456789, 1), ""},
+		{"quarter", Precision{Scale: 2}, big.NewRat(1, 4), ""},
+		{"eighth", Precision{Scale: 2}, big.NewRat(1, 8), "must have at most 2 fractional digits"},
+		{"eighth in scale", Precision{Scale: 3}, big.NewRat(1, 8), ""},
+		{"fifth", Precision{Scale: 1}, big.NewRat(1, 5), ""},
+		{"third never terminates", Precision{Scale: 10}, big.NewRat(1, 3), "must have at most 10 fractional digits"},
+		{"fraction in zero scale", Precision{}, big.NewRat(1, 2), "must have at most 0 fractional digits"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if err := tt.precision.check(tt.value); err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringPrecision(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"123.45", ""},
+		{"-0.10", ""},
+		{"1.", "must be a decimal number"},
+		{".5", ""},
+		{"abc", "must be a decimal number"},
+		{"1e2", "must not use exponent notation"},
+		{"0.001", "must have at most 2 fractional digits"},
+	}
+	validate := StringPrecision(Precision{Digits: 5, Scale: 2})
+	for _, tt := range tests {
+		got := ""
+		if err := validate(tt.input); err != nil {
+			got = err.Error()
+		}
+		if got != tt.want {
+			t.Errorf("%q: got %q, want %q", tt.input, got, tt.want)
+		}
+	}
+	if err := StringPrecision(Precision{Digits: 5, Scale: 2, Exponent: true})("1.5e2"); err != nil {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestFloatPrecision(t *testing.T) {
+	validate := FloatPrecision(Precision{Digits: 5, Scale: 1})
+	if err := validate(0.1); err != nil {
+		t.Errorf("got %v", err)
+	}
+	if err := validate(0.125); err == nil {
+		t.Error("0.125 fits the scale 1")
+	}
+}
+
+func TestStringDecimalBetween(t *testing.T) {
+	validate := StringDecimalBetween("10", "0.1", false)
+	if err := validate("0.1"); err != nil {
+		t.Errorf("got %v", err)
+	}
+	if err := validate("0.09999999999999999999"); err == nil || err.Error() != "0.09999999999999999999 must be between 0.1 and 10" {
+		t.Errorf("got %v", err)
+	}
+	if err := validate("1e0"); err == nil || err.Error() != "must not use exponent notation" {
+		t.Errorf("got %v", err)
+	}
+	if err := StringDecimalBetween("0", "100", true)("1.5e1"); err != nil {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestDecimalExponentLimit(t *testing.T) {
+	validate := StringDecimalBetween("0", "1", true)
+	err := validate("1e1000000")
+	if err == nil || err.Error() != "must have an exponent of at most 1000 in magnitude" {
+		t.Errorf("got %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package please
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Enum is a set of allowed values with constant time membership checks that keeps the declared order of the values
+// for error messages and schemas. Aliases and deprecated members are configured once, before the enum is used.
+type Enum[T comparable] struct {
+	values       []T
+	index        map[T]int
+	aliases      map[T]T
+	deprecated   map[T]bool
+	onDeprecated func(value T)
+	// candidates are the formatted members suggested for invalid values, nil unless the members are strings.
+	candidates  []string
+	maxDistance int
+}
+
+// NewEnum returns an enum of the values in the declared order, repeated values are ignored.
+func NewEnum[T comparable](values ...T) *Enum[T] {
+	e := &Enum[T]{index: make(map[T]int, len(values))}
+	for _, v := range values {
+		if _, ok := e.index[v]; ok {
+			continue
+		}
+		e.index[v] = len(e.values)
+		e.values = append(e.values, v)
+	}
+	if isString[T]() {
+		e.candidates = formatAll(e.values)
+	}
+	return e
+}
+
+// EnumOf returns an enum of the sorted map keys, so the order is stable across runs. Keys of numeric and string kinds are ordered
+// by value, other keys by their default format.
+func EnumOf[T comparable](m map[T]bool) *Enum[T] {
+	return NewEnum(keys(m)...)
+}
+
+// Alias adds the alias of the enum member, aliases are accepted as members and resolved by Canonical.
+// It panics if the member is not in the enum.
+func (e *Enum[T]) Alias(alias, member T) *Enum[T] {
+	if _, ok := e.index[member]; !ok {
+		panic(fmt.Sprintf("please: alias %v of unknown enum member %v", alias, member))
+	}
+	if e.aliases == nil {
+		e.aliases = make(map[T]T)
+	}
+	e.aliases[alias] = member
+	return e
+}
+
+// Deprecate marks the enum members as deprecated, deprecated members are accepted but reported to the OnDeprecated function.
+func (e *Enum[T]) Deprecate(members ...T) *Enum[T] {
+	if e.deprecated == nil {
+		e.deprecated = make(map[T]bool)
+	}
+	for _, m := range members {
+		e.deprecated[m] = true
+	}
+	return e
+}
+
+// OnDeprecated sets the function called with the value when a deprecated member or its alias passes the OneOf validation.
+func (e *Enum[T]) OnDeprecated(warn func(value T)) *Enum[T] {
+	e.onDeprecated = warn
+	return e
+}
+
+// SuggestWithin sets the maximum edit distance of the members suggested for invalid values, see SuggestWithin.
+// Zero restores the default threshold, negative disables the suggestions.
+func (e *Enum[T]) SuggestWithin(maxDistance int) *Enum[T] {
+	e.maxDistance = maxDistance
+	return e
+}
+
+// Values returns the enum members in the declared order.
+func (e *Enum[T]) Values() []T {
+	return slices.Clone(e.values)
+}
+
+// Canonical returns the enum member for the value or its alias, and reports whether the value is a member or an alias.
+func (e *Enum[T]) Canonical(value T) (T, bool) {
+	if _, ok := e.index[value]; ok {
+		return value, true
+	}
+	if member, ok := e.aliases[value]; ok {
+		return member, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Contains reports whether the value is an enum member or an alias of a member.
+func (e *Enum[T]) Contains(value T) bool {
+	_, ok := e.Canonical(value)
+	return ok
+}
+
+// Deprecated reports whether the value is a deprecated enum member or an alias of a deprecated member.
+func (e *Enum[T]) Deprecated(value T) bool {
+	member, ok := e.Canonical(value)
+	return ok && e.deprecated[member]
+}
+
+// OneOf returns a validation function that checks whether the value is an enum member or an alias of a member.
+// The error suggests the enum members closest to the invalid value if the members are strings.
+func (e *Enum[T]) OneOf() Validate[T] {
+	return func(value T) error {
+		if !e.Contains(value) {
+			err := errorf("%v must be one of %v", value, e.values)
+			if e.candidates == nil {
+				return err
+			}
+			return suggest(err, SuggestWithin(fmt.Sprint(value), e.maxDistance, e.candidates...))
+		}
+		if e.onDeprecated != nil && e.Deprecated(value) {
+			e.onDeprecated(value)
+		}
+		return nil
+	}
+}
+
+// NotOneOf returns a validation function that checks whether the value is neither an enum member nor an alias of a member.
+func (e *Enum[T]) NotOneOf() Validate[T] {
+	return func(value T) error {
+		if e.Contains(value) {
+			return errorf("%v must not be one of %v", value, e.values)
+		}
+		return nil
+	}
+}
+
+// NotDeprecated returns a validation function that checks whether the value is not a deprecated enum member.
+func (e *Enum[T]) NotDeprecated() Validate[T] {
+	return func(value T) error {
+		if e.Deprecated(value) {
+			return errorf("%v is deprecated", value)
+		}
+		return nil
+	}
+}
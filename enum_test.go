@@ -0,0 +1,123 @@
+package please
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestEnumValues(t *testing.T) {
+	e := NewEnum("b", "a", "b", "c")
+	if got, want := e.Values(), []string{"b", "a", "c"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	values := e.Values()
+	values[0] = "x"
+	if e.Contains("x") {
+		t.Error("Values returned the internal slice")
+	}
+	if got, want := EnumOf(map[int]bool{10: true, 2: true, 1: true, 3: true}).Values(), []int{1, 2, 3, 10}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestEnumAlias(t *testing.T) {
+	e := NewEnum("active", "inactive").Alias("enabled", "active").Alias("disabled", "inactive")
+	tests := []struct {
+		value  string
+		member string
+		ok     bool
+	}{
+		{"active", "active", true},
+		{"enabled", "active", true},
+		{"disabled", "inactive", true},
+		{"unknown", "", false},
+	}
+	for _, tt := range tests {
+		member, ok := e.Canonical(tt.value)
+		if member != tt.member || ok != tt.ok {
+			t.Errorf("Canonical(%q) = %q, %v, want %q, %v", tt.value, member, ok, tt.member, tt.ok)
+		}
+	}
+	if err := e.OneOf()("enabled"); err != nil {
+		t.Errorf("got %q, want nil", err)
+	}
+	if err := e.NotOneOf()("enabled"); err == nil {
+		t.Error("alias passed NotOneOf")
+	}
+}
+
+func TestEnumAliasUnknownMember(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("alias of an unknown member did not panic")
+		}
+	}()
+	NewEnum("active").Alias("enabled", "activ")
+}
+
+func TestEnumDeprecated(t *testing.T) {
+	var warned []string
+	e := NewEnum("new", "old", "older").Alias("legacy", "old").Deprecate("old", "older").OnDeprecated(func(value string) {
+		warned = append(warned, value)
+	})
+	oneOf := e.OneOf()
+	for _, value := range []string{"new", "old", "legacy", "older"} {
+		if err := oneOf(value); err != nil {
+			t.Errorf("got %q, want nil", err)
+		}
+	}
+	if want := []string{"old", "legacy", "older"}; !slices.Equal(warned, want) {
+		t.Errorf("got %v, want %v", warned, want)
+	}
+	notDeprecated := e.NotDeprecated()
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"new", ""},
+		{"unknown", ""},
+		{"old", "old is deprecated"},
+		{"legacy", "legacy is deprecated"},
+	}
+	for _, tt := range tests {
+		err := notDeprecated(tt.value)
+		if got := errorString(err); got != tt.want {
+			t.Errorf("got %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestEnumOneOf(t *testing.T) {
+	err := NewEnum("pending", "active").OneOf()("pendng")
+	if got, want := errorString(err), `pendng must be one of [pending active], did you mean "pending"?`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestOneIn(t *testing.T) {
+	enum := map[int]bool{10: true, 2: true, 1: true}
+	if err := OneIn(enum)(2); err != nil {
+		t.Errorf("got %q, want nil", err)
+	}
+	if got, want := errorString(OneIn(enum)(3)), "3 must be one in [1 2 10]"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if err := NotOneIn(enum)(3); err != nil {
+		t.Errorf("got %q, want nil", err)
+	}
+	if got, want := errorString(NotOneIn(enum)(10)), "10 must not be one in [1 2 10]"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestKeysOrder(t *testing.T) {
+	if got, want := keys(map[float64]bool{-1.5: true, 10: true, 2: true}), []float64{-1.5, 2, 10}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got, want := keys(map[string]bool{"b": true, "B": true, "a": true}), []string{"B", "a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got, want := keys(map[any]bool{"x": true, 1: true, nil: true}), []any{1, nil, "x"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,45 @@
+package please
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// lazyError is an error whose message is formatted only when it is requested,
+// so errors that are discarded or only counted do not pay for the formatting.
+type lazyError struct {
+	format string
+	args   []any
+}
+
+// Error formats the error message according to the format specifier.
+func (e *lazyError) Error() string {
+	return fmt.Sprintf(e.format, e.args...)
+}
+
+// errorf returns an error that formats its message according to the format specifier when it is requested.
+// The formatting is deferred only if all arguments are scalar values, otherwise the message is formatted immediately,
+// so later changes of slices, maps or pointers passed as arguments do not change the message.
+func errorf(format string, args ...any) error {
+	for _, arg := range args {
+		if !scalar(arg) {
+			return &lazyError{format: "%s", args: []any{fmt.Sprintf(format, args...)}}
+		}
+	}
+	return &lazyError{format: format, args: args}
+}
+
+// scalar reports whether the value is a boolean, number or string, which are copied into the interface value.
+func scalar(value any) bool {
+	if value == nil {
+		return true
+	}
+	switch reflect.TypeOf(value).Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package please
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestErrorfSnapshotsArguments(t *testing.T) {
+	buf := []int{1, 2, 3}
+	err := SliceContain[[]int](9)(buf)
+	buf[0] = 42
+	if got, want := err.Error(), "[1 2 3] must contain 9"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	x := big.NewInt(1)
+	err = MinFunc(big.NewInt(10), (*big.Int).Cmp)(x)
+	x.SetInt64(100)
+	if got, want := err.Error(), "1 must be greater or equal than 10"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestErrorfScalarArguments(t *testing.T) {
+	err := StringMinLen(3)("a")
+	if got, want := err.Error(), "must contain at least 3 characters"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+var (
+	hotString = strings.Repeat("abc123", 5)
+	hotSlice  = []string{"alpha", "bravo", "charlie", "delta"}
+	hotRules  = []Validate[string]{
+		StringMinLen(3),
+		StringMaxLen(64),
+		StringMaxRuneCount(64),
+		StringMinUniqueRuneCount(3),
+		StringMaxUniqueRuneCount(32),
+		StringAlphaNumeric(),
+		StringNotContains(" "),
+	}
+)
+
+func TestHotPathAllocations(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func()
+	}{
+		{"Collect", func() { _ = Collect(hotString, hotRules...) }},
+		{"Join", func() { _ = Join(hotString, hotRules...) }},
+		{"Abort", func() { _ = Abort(hotString, hotRules...) }},
+		{"SliceEach", func() { _ = SliceEach[[]string](hotRules...)(hotSlice) }},
+		{"SliceLenBetween", func() { _ = SliceLenBetween[[]string](1, 10)(hotSlice) }},
+		{"SliceContain", func() { _ = SliceContain[[]string]("delta")(hotSlice) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if allocs := testing.AllocsPerRun(100, tt.run); allocs != 0 {
+				t.Errorf("got %v allocations, want 0", allocs)
+			}
+		})
+	}
+}
+
+func BenchmarkCollect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Collect(hotString, hotRules...)
+	}
+}
+
+func BenchmarkJoin(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Join(hotString, hotRules...)
+	}
+}
+
+func BenchmarkSliceEach(b *testing.B) {
+	validate := SliceEach[[]string](hotRules...)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = validate(hotSlice)
+	}
+}
+
+func BenchmarkJoinFailing(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Join("a b", hotRules...)
+	}
+}
+
+// errorString returns the error message, or an empty string for a nil error.
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,52 @@
+package please
+
+import (
+	"strconv"
+	"strings"
+)
+
+// FieldError is an error that occurred while validating a named field or an element of a collection.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+// Error returns the error message of the underlying error with each line prefixed by the field name.
+func (e *FieldError) Error() string {
+	lines := strings.Split(e.Err.Error(), "\n")
+	for i := range lines {
+		lines[i] = e.Field + ": " + lines[i]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Unwrap returns the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Field returns a validation function that checks the value with the specified validation functions and annotates the errors with the field name.
+func Field[T any](name string, opts ...Validate[T]) Validate[T] {
+	return func(value T) error {
+		if err := Join(value, opts...); err != nil {
+			return &FieldError{Field: name, Err: err}
+		}
+		return nil
+	}
+}
+
+// Index returns the field name of the collection element with the specified index.
+func Index(i int) string {
+	return "[" + strconv.Itoa(i) + "]"
+}
+
+// JoinPath joins the parent path and the field name into a single path, like "users[0].name".
+func JoinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	if strings.HasPrefix(field, "[") {
+		return path + field
+	}
+	return path + "." + field
+}
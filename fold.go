@@ -0,0 +1,119 @@
+package please
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// foldRune returns the smallest rune of the unicode case folding orbit of the rune,
+// so runes that are equal under simple case folding have the same folded rune.
+func foldRune(char rune) rune {
+	folded := char
+	for f := unicode.SimpleFold(char); f != char; f = unicode.SimpleFold(f) {
+		folded = min(folded, f)
+	}
+	return folded
+}
+
+// foldString returns the string with every rune replaced by its folded rune.
+func foldString(s string) string {
+	return strings.Map(foldRune, s)
+}
+
+// hasPrefixFold reports whether the string begins with the prefix under simple unicode case folding.
+func hasPrefixFold(s, prefix string) bool {
+	for prefix != "" {
+		if s == "" {
+			return false
+		}
+		x, n := utf8.DecodeRuneInString(s)
+		y, m := utf8.DecodeRuneInString(prefix)
+		if x != y && foldRune(x) != foldRune(y) {
+			return false
+		}
+		s, prefix = s[n:], prefix[m:]
+	}
+	return true
+}
+
+// hasSuffixFold reports whether the string ends with the suffix under simple unicode case folding.
+func hasSuffixFold(s, suffix string) bool {
+	for suffix != "" {
+		if s == "" {
+			return false
+		}
+		x, n := utf8.DecodeLastRuneInString(s)
+		y, m := utf8.DecodeLastRuneInString(suffix)
+		if x != y && foldRune(x) != foldRune(y) {
+			return false
+		}
+		s, suffix = s[:len(s)-n], suffix[:len(suffix)-m]
+	}
+	return true
+}
+
+// EqualFold returns a validation function that checks whether the value is equal to the target under unicode case folding.
+func EqualFold[S ~string](target S) Validate[S] {
+	return func(value S) error {
+		if !strings.EqualFold(string(value), string(target)) {
+			return errorf("%v must be equal to %v ignoring case", value, target)
+		}
+		return nil
+	}
+}
+
+// OneOfFold returns a validation function that checks whether the value exists in the enum slice under unicode case folding.
+// The folded enum values are precomputed into a set, so the check does not depend on the enum size.
+func OneOfFold[S ~string](enum ...S) Validate[S] {
+	enum = append([]S(nil), enum...)
+	set := make(map[string]bool, len(enum))
+	folded := make([]string, 0, len(enum))
+	for _, e := range enum {
+		f := foldString(string(e))
+		set[f] = true
+		folded = append(folded, f)
+	}
+	return func(value S) error {
+		f := foldString(string(value))
+		if !set[f] {
+			var suggestions []string
+			for _, i := range closest(f, folded, 0) {
+				suggestions = append(suggestions, string(enum[i]))
+			}
+			return suggest(errorf("%v must be one of %v ignoring case", value, enum), suggestions)
+		}
+		return nil
+	}
+}
+
+// StringContainsFold returns a validation function that checks whether the string contains the specified substring under unicode case folding.
+func StringContainsFold(substr string) Validate[string] {
+	folded := foldString(substr)
+	return func(s string) error {
+		if !strings.Contains(foldString(s), folded) {
+			return errorf("must contain %q ignoring case", substr)
+		}
+		return nil
+	}
+}
+
+// StringHasPrefixFold returns a validation function that checks whether the string begins with prefix under unicode case folding.
+func StringHasPrefixFold(prefix string) Validate[string] {
+	return func(s string) error {
+		if !hasPrefixFold(s, prefix) {
+			return errorf("must contain prefix %q ignoring case", prefix)
+		}
+		return nil
+	}
+}
+
+// StringHasSuffixFold returns a validation function that checks whether the string ends with suffix under unicode case folding.
+func StringHasSuffixFold(suffix string) Validate[string] {
+	return func(s string) error {
+		if !hasSuffixFold(s, suffix) {
+			return errorf("must contain suffix %q ignoring case", suffix)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,78 @@
+package please
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestHasPrefixSuffixFold(t *testing.T) {
+	tests := []struct {
+		s, affix       string
+		prefix, suffix bool
+	}{
+		{"Hello", "hE", true, false},
+		{"Hello", "LO", false, true},
+		{"", "", true, true},
+		{"abc", "", true, true},
+		{"a", "ab", false, false},
+		// Runes of different encoded lengths fold together: the long s and the Kelvin sign take 2 and 3 bytes.
+		{"\u017fTRASSE", "st", true, false},
+		{"STRASSE", "\u017ft", true, false},
+		{"\u212aelvin", "KEL", true, false},
+		{"bookmark", "MAR\u212a", false, true},
+		{"ΣΊΣΥΦΟΣ", "σίσ", true, false},
+		{"σίσυφος", "ΦΟΣ", false, true},
+		{"привет", "ПРИ", true, false},
+		{"привет", "ВЕТ", false, true},
+		{"привет", "ВЕД", false, false},
+		// A multi-byte rune must not match the prefix of its encoding.
+		{"été", "ÉTÉ", true, true},
+		{"é", "e", false, false},
+	}
+	for _, tt := range tests {
+		if got := hasPrefixFold(tt.s, tt.affix); got != tt.prefix {
+			t.Errorf("hasPrefixFold(%q, %q) = %v, want %v", tt.s, tt.affix, got, tt.prefix)
+		}
+		if got := hasSuffixFold(tt.s, tt.affix); got != tt.suffix {
+			t.Errorf("hasSuffixFold(%q, %q) = %v, want %v", tt.s, tt.affix, got, tt.suffix)
+		}
+	}
+}
+
+func TestFoldRules(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"equal", EqualFold("Stra\u00dfe")("STRA\u1e9eE"), ""},
+		{"equal kelvin", EqualFold("kelvin")("\u212aELVIN"), ""},
+		{"not equal", EqualFold("a")("b"), "b must be equal to a ignoring case"},
+		{"contains", StringContainsFold("ПРИВ")("Привет"), ""},
+		{"not contains", StringContainsFold("x")("abc"), `must contain "x" ignoring case`},
+		{"prefix", StringHasPrefixFold("ПРИ")("привет"), ""},
+		{"suffix", StringHasSuffixFold("ЕТ")("привет"), ""},
+		{"one of", OneOfFold("Active", "Paused")("ACTIVE"), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorString(tt.err); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if StringHasPrefixFold("x")("abc") == nil || StringHasSuffixFold("x")("abc") == nil {
+		t.Error("missing affix passed")
+	}
+}
+
+func TestOneOfFoldSuggestions(t *testing.T) {
+	var se *SuggestionError
+	if !errors.As(OneOfFold("Pending", "Active")("PENDNG"), &se) {
+		t.Fatal("got no suggestions")
+	}
+	if want := []string{"Pending"}; !slices.Equal(se.Suggestions, want) {
+		t.Errorf("got %q, want %q", se.Suggestions, want)
+	}
+}
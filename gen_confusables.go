@@ -0,0 +1,82 @@
+//go:build ignore
+
+// This program generates confusables.txt from the Unicode confusables.txt file, see UTS #39 section 4.
+// It keeps the original header with the Unicode version and the mappings without the trailing comments.
+// The default URL is pinned to the Unicode version of the checked-in file, pass -url to upgrade it.
+//
+//	go run gen_confusables.go [-url URL | -file FILE]
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+)
+
+var (
+	url  = flag.String("url", "https://www.unicode.org/Public/security/13.0.0/confusables.txt", "URL of the Unicode confusables.txt file")
+	file = flag.String("file", "", "path of a local copy of the Unicode confusables.txt file, overrides -url")
+	out  = flag.String("output", "confusables.txt", "output file name")
+)
+
+func main() {
+	flag.Parse()
+	src, err := open()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer src.Close()
+
+	var b strings.Builder
+	header, mappings := true, 0
+	scanner := bufio.NewScanner(src)
+	for scanner.Scan() {
+		line := strings.TrimPrefix(scanner.Text(), "\ufeff")
+		if header {
+			if strings.HasPrefix(line, "#") {
+				b.WriteString(line + "\n")
+				continue
+			}
+			header = false
+			b.WriteString("\n")
+		}
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Split(line, ";")
+		if len(fields) < 3 {
+			continue
+		}
+		fmt.Fprintf(&b, "%s ;\t%s ;\t%s\n", strings.TrimSpace(fields[0]), strings.TrimSpace(fields[1]), strings.TrimSpace(fields[2]))
+		mappings++
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	if mappings == 0 {
+		log.Fatal("no mappings found")
+	}
+	if err := os.WriteFile(*out, []byte(b.String()), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("wrote %d mappings to %s", mappings, *out)
+}
+
+// open returns the reader of the local file or the response body of the URL.
+func open() (io.ReadCloser, error) {
+	if *file != "" {
+		return os.Open(*file)
+	}
+	resp, err := http.Get(*url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("GET %s: %s", *url, resp.Status)
+	}
+	return resp.Body, nil
+}
@@ -40,19 +40,7 @@ func Abort[T any](value T, opts ...Validate[T]) error {
 
 // Collect collects all errors when executing the validation functions.
 func Collect[T any](value T, opts ...Validate[T]) []error {
-	if len(opts) == 0 {
-		return nil
-	}
-	errs := make([]error, 0, len(opts))
-	for _, v := range opts {
-		if err := v(value); err != nil {
-			errs = append(errs, err)
-		}
-	}
-	if len(errs) == 0 {
-		return nil
-	}
-	return errs
+	return CollectBudget(value, Budget{}, opts...)
 }
 
 // JoinFunc joins all errors using the specified join function when executing the validation functions.
@@ -75,3 +63,10 @@ func Nothing[T any]() Validate[T] {
 		return nil
 	}
 }
+
+// Transform returns a validation function that checks the value transformed by the specified function with the validation functions.
+func Transform[T any](transform func(T) T, opts ...Validate[T]) Validate[T] {
+	return func(value T) error {
+		return Join(transform(value), opts...)
+	}
+}
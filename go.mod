@@ -2,4 +2,8 @@ module github.com/zhassymov/please
 
 go 1.22.0
 
-require github.com/google/uuid v1.6.0
+require (
+	github.com/google/uuid v1.6.0
+	github.com/rivo/uniseg v0.4.7
+	golang.org/x/text v0.22.0
+)
@@ -0,0 +1,117 @@
+package please
+
+import "github.com/rivo/uniseg"
+
+// StringGraphemeCount returns a validation function that checks whether the number of grapheme clusters (user-perceived characters)
+// in the string is exactly equal to the specified number. Grapheme clusters are determined according to UAX #29.
+func StringGraphemeCount(n int) Validate[string] {
+	return func(s string) error {
+		if uniseg.GraphemeClusterCount(s) != n {
+			return errorf("must contain exactly %d characters", n)
+		}
+		return nil
+	}
+}
+
+// StringMinGraphemeCount returns a validation function that checks whether the number of grapheme clusters in the string is at least the specified number.
+func StringMinGraphemeCount(n int) Validate[string] {
+	return func(s string) error {
+		if uniseg.GraphemeClusterCount(s) < n {
+			return errorf("must contain at least %d characters", n)
+		}
+		return nil
+	}
+}
+
+// StringMaxGraphemeCount returns a validation function that checks whether the number of grapheme clusters in the string is at most the specified number.
+func StringMaxGraphemeCount(n int) Validate[string] {
+	return func(s string) error {
+		if uniseg.GraphemeClusterCount(s) > n {
+			return errorf("must contain at most %d characters", n)
+		}
+		return nil
+	}
+}
+
+// StringGraphemeCountBetween returns a validation function that checks whether the number of grapheme clusters in the string is between the specified numbers.
+func StringGraphemeCountBetween(x, y int) Validate[string] {
+	return func(s string) error {
+		minimal := min(x, y)
+		maximal := max(x, y)
+		count := uniseg.GraphemeClusterCount(s)
+		if count < minimal || count > maximal {
+			return errorf("must contain from %d to %d characters", minimal, maximal)
+		}
+		return nil
+	}
+}
+
+// StringGraphemeCountNotBetween returns a validation function that checks whether the number of grapheme clusters in the string is not between the specified numbers.
+func StringGraphemeCountNotBetween(x, y int) Validate[string] {
+	return func(s string) error {
+		minimal := min(x, y)
+		maximal := max(x, y)
+		count := uniseg.GraphemeClusterCount(s)
+		if count >= minimal && count <= maximal {
+			return errorf("must contain up to %d or more than %d characters", minimal, maximal)
+		}
+		return nil
+	}
+}
+
+// StringWidth returns a validation function that checks whether the display width of the string in monospace columns
+// is exactly equal to the specified number. Wide and fullwidth East Asian characters and emoji occupy two columns.
+func StringWidth(n int) Validate[string] {
+	return func(s string) error {
+		if uniseg.StringWidth(s) != n {
+			return errorf("must be exactly %d columns wide", n)
+		}
+		return nil
+	}
+}
+
+// StringMinWidth returns a validation function that checks whether the display width of the string is at least the specified number of columns.
+func StringMinWidth(n int) Validate[string] {
+	return func(s string) error {
+		if uniseg.StringWidth(s) < n {
+			return errorf("must be at least %d columns wide", n)
+		}
+		return nil
+	}
+}
+
+// StringMaxWidth returns a validation function that checks whether the display width of the string is at most the specified number of columns.
+func StringMaxWidth(n int) Validate[string] {
+	return func(s string) error {
+		if uniseg.StringWidth(s) > n {
+			return errorf("must be at most %d columns wide", n)
+		}
+		return nil
+	}
+}
+
+// StringWidthBetween returns a validation function that checks whether the display width of the string is between the specified numbers of columns.
+func StringWidthBetween(x, y int) Validate[string] {
+	return func(s string) error {
+		minimal := min(x, y)
+		maximal := max(x, y)
+		width := uniseg.StringWidth(s)
+		if width < minimal || width > maximal {
+			return errorf("must be from %d to %d columns wide", minimal, maximal)
+		}
+		return nil
+	}
+}
+
+// StringWidthNotBetween returns a validation function that checks whether the display width of the string is not between the specified numbers of columns.
+func StringWidthNotBetween(x, y int) Validate[string] {
+	return func(s string) error {
+		minimal := min(x, y)
+		maximal := max(x, y)
+		width := uniseg.StringWidth(s)
+		if width >= minimal && width <= maximal {
+			return errorf("must be up to %d or more than %d columns wide", minimal, maximal)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,48 @@
+package please
+
+import "testing"
+
+func TestGraphemeRules(t *testing.T) {
+	const (
+		decomposed = "e\u0301"
+		family     = "\U0001f468\u200d\U0001f469\u200d\U0001f467"
+		flag       = "\U0001f1f0\U0001f1ff"
+	)
+	tests := []struct {
+		name  string
+		rule  Validate[string]
+		input string
+		want  string
+	}{
+		{"count ascii", StringGraphemeCount(3), "abc", ""},
+		{"count combining mark", StringGraphemeCount(1), decomposed, ""},
+		{"count zwj sequence", StringGraphemeCount(1), family, ""},
+		{"count flag", StringGraphemeCount(2), flag + decomposed, ""},
+		{"count mismatch", StringGraphemeCount(2), family, "must contain exactly 2 characters"},
+		{"min", StringMinGraphemeCount(2), decomposed, "must contain at least 2 characters"},
+		{"max", StringMaxGraphemeCount(1), family, ""},
+		{"max above", StringMaxGraphemeCount(1), "ab", "must contain at most 1 characters"},
+		{"between swapped", StringGraphemeCountBetween(3, 1), family + flag, ""},
+		{"between below", StringGraphemeCountBetween(3, 2), family, "must contain from 2 to 3 characters"},
+		{"not between", StringGraphemeCountNotBetween(2, 3), family, ""},
+		{"not between inside", StringGraphemeCountNotBetween(3, 2), "ab", "must contain up to 2 or more than 3 characters"},
+		{"width ascii", StringWidth(3), "abc", ""},
+		{"width east asian", StringWidth(4), "日本", ""},
+		{"width emoji", StringWidth(2), family, ""},
+		{"width combining mark", StringWidth(1), decomposed, ""},
+		{"width mismatch", StringWidth(2), "日本", "must be exactly 2 columns wide"},
+		{"min width", StringMinWidth(3), "日", "must be at least 3 columns wide"},
+		{"max width", StringMaxWidth(3), "日本", "must be at most 3 columns wide"},
+		{"width between swapped", StringWidthBetween(4, 2), "日本", ""},
+		{"width between above", StringWidthBetween(1, 3), "日本", "must be from 1 to 3 columns wide"},
+		{"width not between", StringWidthNotBetween(1, 3), "日本", ""},
+		{"width not between inside", StringWidthNotBetween(3, 1), "日", "must be up to 1 or more than 3 columns wide"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorString(tt.rule(tt.input)); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package please
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+)
+
+// Bound is an endpoint of an interval.
+type Bound[T cmp.Ordered] struct {
+	// Value is the endpoint value, it is ignored if the bound is unbounded.
+	Value T
+	// Open excludes the endpoint value from the interval.
+	Open bool
+	// Unbounded extends the interval to infinity on the side of the bound.
+	Unbounded bool
+}
+
+// Interval is a range of ordered values with closed, open or unbounded endpoints, like [0, 100) or (-∞, 5].
+// An interval with the lower bound greater than the upper bound is reversed and contains no values.
+type Interval[T cmp.Ordered] struct {
+	Lower Bound[T]
+	Upper Bound[T]
+}
+
+// Closed returns the interval [a, b] that includes both endpoints.
+func Closed[T cmp.Ordered](a, b T) Interval[T] {
+	return Interval[T]{Lower: Bound[T]{Value: a}, Upper: Bound[T]{Value: b}}
+}
+
+// Open returns the interval (a, b) that excludes both endpoints.
+func Open[T cmp.Ordered](a, b T) Interval[T] {
+	return Interval[T]{Lower: Bound[T]{Value: a, Open: true}, Upper: Bound[T]{Value: b, Open: true}}
+}
+
+// ClosedOpen returns the half-open interval [a, b) that includes a and excludes b.
+func ClosedOpen[T cmp.Ordered](a, b T) Interval[T] {
+	return Interval[T]{Lower: Bound[T]{Value: a}, Upper: Bound[T]{Value: b, Open: true}}
+}
+
+// OpenClosed returns the half-open interval (a, b] that excludes a and includes b.
+func OpenClosed[T cmp.Ordered](a, b T) Interval[T] {
+	return Interval[T]{Lower: Bound[T]{Value: a, Open: true}, Upper: Bound[T]{Value: b}}
+}
+
+// AtLeast returns the interval [a, +∞).
+func AtLeast[T cmp.Ordered](a T) Interval[T] {
+	return Interval[T]{Lower: Bound[T]{Value: a}, Upper: Bound[T]{Unbounded: true}}
+}
+
+// GreaterThan returns the interval (a, +∞).
+func GreaterThan[T cmp.Ordered](a T) Interval[T] {
+	return Interval[T]{Lower: Bound[T]{Value: a, Open: true}, Upper: Bound[T]{Unbounded: true}}
+}
+
+// AtMost returns the interval (-∞, b].
+func AtMost[T cmp.Ordered](b T) Interval[T] {
+	return Interval[T]{Lower: Bound[T]{Unbounded: true}, Upper: Bound[T]{Value: b}}
+}
+
+// LessThan returns the interval (-∞, b).
+func LessThan[T cmp.Ordered](b T) Interval[T] {
+	return Interval[T]{Lower: Bound[T]{Unbounded: true}, Upper: Bound[T]{Value: b, Open: true}}
+}
+
+// Unbounded returns the interval (-∞, +∞) that contains all values.
+func Unbounded[T cmp.Ordered]() Interval[T] {
+	return Interval[T]{Lower: Bound[T]{Unbounded: true}, Upper: Bound[T]{Unbounded: true}}
+}
+
+// Contains reports whether the value is in the interval. NaN is not in any interval.
+func (i Interval[T]) Contains(value T) bool {
+	if value != value {
+		return false
+	}
+	if !i.Lower.Unbounded {
+		if c := cmp.Compare(value, i.Lower.Value); c < 0 || c == 0 && i.Lower.Open {
+			return false
+		}
+	}
+	if !i.Upper.Unbounded {
+		if c := cmp.Compare(value, i.Upper.Value); c > 0 || c == 0 && i.Upper.Open {
+			return false
+		}
+	}
+	return true
+}
+
+// Reversed reports whether the lower bound of the interval is greater than the upper bound.
+func (i Interval[T]) Reversed() bool {
+	return !i.Lower.Unbounded && !i.Upper.Unbounded && cmp.Less(i.Upper.Value, i.Lower.Value)
+}
+
+// Empty reports whether the interval contains no values: it is reversed, or its endpoints are equal and one of them is open.
+func (i Interval[T]) Empty() bool {
+	if i.Reversed() {
+		return true
+	}
+	return !i.Lower.Unbounded && !i.Upper.Unbounded && i.Lower.Value == i.Upper.Value && (i.Lower.Open || i.Upper.Open)
+}
+
+// Check returns an error if the interval is reversed.
+func (i Interval[T]) Check() error {
+	if i.Reversed() {
+		return errorf("invalid interval %s: lower bound is greater than upper bound", i)
+	}
+	return nil
+}
+
+// String returns the interval in mathematical notation, like [0, 100) or (-∞, 5].
+func (i Interval[T]) String() string {
+	var b strings.Builder
+	switch {
+	case i.Lower.Unbounded:
+		b.WriteString("(-∞")
+	case i.Lower.Open:
+		fmt.Fprintf(&b, "(%v", i.Lower.Value)
+	default:
+		fmt.Fprintf(&b, "[%v", i.Lower.Value)
+	}
+	b.WriteString(", ")
+	switch {
+	case i.Upper.Unbounded:
+		b.WriteString("+∞)")
+	case i.Upper.Open:
+		fmt.Fprintf(&b, "%v)", i.Upper.Value)
+	default:
+		fmt.Fprintf(&b, "%v]", i.Upper.Value)
+	}
+	return b.String()
+}
+
+// IntervalSet is a union of intervals.
+type IntervalSet[T cmp.Ordered] []Interval[T]
+
+// Contains reports whether the value is in any of the intervals.
+func (s IntervalSet[T]) Contains(value T) bool {
+	for _, i := range s {
+		if i.Contains(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the union of the intervals in mathematical notation, like [0, 10) ∪ [20, 30].
+func (s IntervalSet[T]) String() string {
+	if len(s) == 0 {
+		return "∅"
+	}
+	parts := make([]string, 0, len(s))
+	for _, i := range s {
+		parts = append(parts, i.String())
+	}
+	return strings.Join(parts, " ∪ ")
+}
+
+// InInterval returns a validation function that checks whether the value is in any of the intervals.
+// Reversed intervals contain no values, use InIntervalStrict to reject them.
+func InInterval[T cmp.Ordered](intervals ...Interval[T]) Validate[T] {
+	set := IntervalSet[T](append([]Interval[T](nil), intervals...))
+	return func(value T) error {
+		if !set.Contains(value) {
+			return errorf("%v must be in %s", value, set)
+		}
+		return nil
+	}
+}
+
+// NotInInterval returns a validation function that checks whether the value is not in any of the intervals.
+func NotInInterval[T cmp.Ordered](intervals ...Interval[T]) Validate[T] {
+	set := IntervalSet[T](append([]Interval[T](nil), intervals...))
+	return func(value T) error {
+		if set.Contains(value) {
+			return errorf("%v must not be in %s", value, set)
+		}
+		return nil
+	}
+}
+
+// InIntervalStrict returns a validation function that checks whether the value is in any of the intervals,
+// unlike InInterval it reports an error for reversed intervals instead of treating them as empty.
+func InIntervalStrict[T cmp.Ordered](intervals ...Interval[T]) Validate[T] {
+	set := IntervalSet[T](append([]Interval[T](nil), intervals...))
+	err := set.check()
+	return func(value T) error {
+		if err != nil {
+			return err
+		}
+		if !set.Contains(value) {
+			return errorf("%v must be in %s", value, set)
+		}
+		return nil
+	}
+}
+
+// NotInIntervalStrict returns a validation function that checks whether the value is not in any of the intervals,
+// unlike NotInInterval it reports an error for reversed intervals instead of treating them as empty.
+func NotInIntervalStrict[T cmp.Ordered](intervals ...Interval[T]) Validate[T] {
+	set := IntervalSet[T](append([]Interval[T](nil), intervals...))
+	err := set.check()
+	return func(value T) error {
+		if err != nil {
+			return err
+		}
+		if set.Contains(value) {
+			return errorf("%v must not be in %s", value, set)
+		}
+		return nil
+	}
+}
+
+// check returns the error of the first reversed interval.
+func (s IntervalSet[T]) check() error {
+	for _, i := range s {
+		if err := i.Check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
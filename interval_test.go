@@ -0,0 +1,76 @@
+package please
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntervalContains(t *testing.T) {
+	tests := []struct {
+		interval Interval[float64]
+		value    float64
+		want     bool
+	}{
+		{Closed(0.0, 10.0), 0, true},
+		{Closed(0.0, 10.0), 10, true},
+		{Open(0.0, 10.0), 0, false},
+		{Open(0.0, 10.0), 10, false},
+		{ClosedOpen(0.0, 10.0), 10, false},
+		{OpenClosed(0.0, 10.0), 10, true},
+		{AtLeast(5.0), math.Inf(1), true},
+		{AtMost(5.0), math.Inf(-1), true},
+		{AtMost(5.0), math.NaN(), false},
+		{LessThan(100.0), math.NaN(), false},
+		{Unbounded[float64](), math.NaN(), false},
+		{Closed(10.0, 0.0), 5, false},
+	}
+	for _, tt := range tests {
+		if got := tt.interval.Contains(tt.value); got != tt.want {
+			t.Errorf("%s.Contains(%v) = %v, want %v", tt.interval, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestIntervalString(t *testing.T) {
+	tests := []struct {
+		interval Interval[int]
+		want     string
+	}{
+		{ClosedOpen(0, 100), "[0, 100)"},
+		{AtMost(5), "(-∞, 5]"},
+		{GreaterThan(1), "(1, +∞)"},
+	}
+	for _, tt := range tests {
+		if got := tt.interval.String(); got != tt.want {
+			t.Errorf("got %q, want %q", got, tt.want)
+		}
+	}
+	if got := (IntervalSet[int]{}).String(); got != "∅" {
+		t.Errorf("got %q, want ∅", got)
+	}
+}
+
+func TestInInterval(t *testing.T) {
+	price := InInterval(LessThan(100.0))
+	if err := price(math.NaN()); err == nil {
+		t.Error("NaN passed the interval rule")
+	}
+	if err := InInterval(ClosedOpen(0, 10), Closed(20, 30))(15); err == nil || err.Error() != "15 must be in [0, 10) ∪ [20, 30]" {
+		t.Errorf("got %v", err)
+	}
+	if err := NotInInterval(Closed(0, 10))(5); err == nil {
+		t.Error("5 passed NotInInterval [0, 10]")
+	}
+}
+
+func TestInIntervalStrict(t *testing.T) {
+	if err := InIntervalStrict(Closed(10, 0))(5); err == nil || err.Error() != "invalid interval [10, 0]: lower bound is greater than upper bound" {
+		t.Errorf("got %v", err)
+	}
+	if err := NotInIntervalStrict(Closed(10, 0))(50); err == nil {
+		t.Error("reversed interval passed NotInIntervalStrict")
+	}
+	if err := InIntervalStrict(Closed(0, 10))(5); err != nil {
+		t.Errorf("got %v", err)
+	}
+}
@@ -0,0 +1,39 @@
+package please
+
+import "golang.org/x/text/unicode/norm"
+
+// stringNormalForm returns a validation function that checks whether the string is in the normalization form.
+func stringNormalForm(form norm.Form, name string) Validate[string] {
+	return func(s string) error {
+		if !form.IsNormalString(s) {
+			return errorf("must be in %s normalization form", name)
+		}
+		return nil
+	}
+}
+
+// StringNFC returns a validation function that checks whether the string is in Unicode normalization form C (canonical composition).
+func StringNFC() Validate[string] {
+	return stringNormalForm(norm.NFC, "NFC")
+}
+
+// StringNFD returns a validation function that checks whether the string is in Unicode normalization form D (canonical decomposition).
+func StringNFD() Validate[string] {
+	return stringNormalForm(norm.NFD, "NFD")
+}
+
+// StringNFKC returns a validation function that checks whether the string is in Unicode normalization form KC (compatibility composition).
+func StringNFKC() Validate[string] {
+	return stringNormalForm(norm.NFKC, "NFKC")
+}
+
+// StringNFKD returns a validation function that checks whether the string is in Unicode normalization form KD (compatibility decomposition).
+func StringNFKD() Validate[string] {
+	return stringNormalForm(norm.NFKD, "NFKD")
+}
+
+// StringNormalize returns a validation function that normalizes the string to the normalization form before checking it with the validation functions,
+// so composed and decomposed input is validated consistently, like StringNormalize(norm.NFC, StringMaxRuneCount(16)).
+func StringNormalize(form norm.Form, opts ...Validate[string]) Validate[string] {
+	return Transform(form.String, opts...)
+}
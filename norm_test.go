@@ -0,0 +1,52 @@
+package please
+
+import (
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestNormalFormRules(t *testing.T) {
+	const (
+		composed   = "caf\u00e9"
+		decomposed = "cafe\u0301"
+		ligature   = "\ufb01le"
+	)
+	tests := []struct {
+		name  string
+		rule  Validate[string]
+		input string
+		want  string
+	}{
+		{"nfc composed", StringNFC(), composed, ""},
+		{"nfc decomposed", StringNFC(), decomposed, "must be in NFC normalization form"},
+		{"nfd decomposed", StringNFD(), decomposed, ""},
+		{"nfd composed", StringNFD(), composed, "must be in NFD normalization form"},
+		{"nfc ligature", StringNFC(), ligature, ""},
+		{"nfkc ligature", StringNFKC(), ligature, "must be in NFKC normalization form"},
+		{"nfkc plain", StringNFKC(), "file", ""},
+		{"nfkd composed", StringNFKD(), composed, "must be in NFKD normalization form"},
+		{"nfkd decomposed", StringNFKD(), decomposed, ""},
+		{"ascii", StringNFD(), "abc", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorString(tt.rule(tt.input)); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringNormalize(t *testing.T) {
+	rule := StringNormalize(norm.NFC, StringMaxRuneCount(4), StringNFC())
+	if err := rule("cafe\u0301"); err != nil {
+		t.Errorf("got %q, want nil", err)
+	}
+	if got, want := errorString(StringMaxRuneCount(4)("cafe\u0301")), "must contain at most 4 characters"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := errorString(StringNormalize(norm.NFKC, Equal("file"))("\ufb01le!")), "file! must be equal to file"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
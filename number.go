@@ -0,0 +1,140 @@
+package please
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// NumberFormat describes how numbers are written in a locale, like "1,234.56" or "1.234,56".
+type NumberFormat struct {
+	// Decimal is the decimal separator, zero means '.'.
+	Decimal rune
+	// Group is the grouping separator of the thousands, zero disallows grouping. It must differ from the decimal separator.
+	// Grouping is optional, "1234" and "1,234" are both valid when Group is ','.
+	Group rune
+	// LeadingZeros allows leading zeros in the integer part, like "007".
+	LeadingZeros bool
+}
+
+// Predefined number formats.
+var (
+	// FormatPlain is the format of Go literals without grouping, like "-1234.56".
+	FormatPlain = NumberFormat{Decimal: '.'}
+	// FormatEnglish is the English format, like "1,234.56".
+	FormatEnglish = NumberFormat{Decimal: '.', Group: ','}
+	// FormatGerman is the German format, like "1.234,56".
+	FormatGerman = NumberFormat{Decimal: ',', Group: '.'}
+	// FormatFrench is the French format with a space grouping separator, like "1 234,56".
+	FormatFrench = NumberFormat{Decimal: ',', Group: ' '}
+	// FormatSwiss is the Swiss format, like "1'234.56".
+	FormatSwiss = NumberFormat{Decimal: '.', Group: '\''}
+)
+
+// parse returns the number string in the plain format, like "-1234.56", and reports whether it has a fractional part.
+func (f NumberFormat) parse(s string) (string, bool, error) {
+	decimal := f.Decimal
+	if decimal == 0 {
+		decimal = '.'
+	}
+	if f.Group == decimal {
+		return "", false, errorf("invalid number format: grouping separator %q equals the decimal separator", f.Group)
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		if s[0] == '-' {
+			b.WriteByte('-')
+		}
+		s = s[1:]
+	}
+	integer, fraction, hasFraction := strings.Cut(s, string(decimal))
+	if integer == "" || hasFraction && fraction == "" {
+		return "", false, errors.New("must be a number")
+	}
+	digits, group := 0, -1
+	for _, char := range integer {
+		switch {
+		case char >= '0' && char <= '9':
+			if digits == 0 && char == '0' && len(integer) > 1 && !f.LeadingZeros {
+				return "", false, errors.New("must not have leading zeros")
+			}
+			b.WriteRune(char)
+			digits++
+		case char == f.Group && f.Group != 0:
+			// The first group has 1 to 3 digits, the other groups have exactly 3 digits.
+			if group < 0 && (digits == 0 || digits > 3) || group >= 0 && digits-group != 3 {
+				return "", false, errors.New("must group digits by three")
+			}
+			group = digits
+		default:
+			return "", false, errorf("must be a number, unexpected %q", char)
+		}
+	}
+	if group >= 0 && digits-group != 3 {
+		return "", false, errors.New("must group digits by three")
+	}
+	if hasFraction {
+		b.WriteByte('.')
+		for _, char := range fraction {
+			if char < '0' || char > '9' {
+				return "", false, errorf("must be a number, unexpected %q", char)
+			}
+			b.WriteRune(char)
+		}
+	}
+	return b.String(), hasFraction, nil
+}
+
+// StringInteger returns a validation function that checks whether the string is an integer in the number format,
+// and checks the parsed value with the validation functions, like StringInteger(FormatEnglish, Between[int64](1, 1000)).
+func StringInteger(f NumberFormat, opts ...Validate[int64]) Validate[string] {
+	return func(s string) error {
+		plain, fraction, err := f.parse(s)
+		if err != nil {
+			return err
+		}
+		if fraction {
+			return errors.New("must be an integer")
+		}
+		n, err := strconv.ParseInt(plain, 10, 64)
+		if err != nil {
+			return errorf("must fit in int64 [%d, %d]", int64(math.MinInt64), int64(math.MaxInt64))
+		}
+		return Join(n, opts...)
+	}
+}
+
+// StringFloat returns a validation function that checks whether the string is a number in the number format,
+// and checks the parsed floating-point value with the validation functions.
+func StringFloat(f NumberFormat, opts ...Validate[float64]) Validate[string] {
+	return func(s string) error {
+		plain, _, err := f.parse(s)
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseFloat(plain, 64)
+		if err != nil {
+			return errors.New("must fit in float64")
+		}
+		return Join(n, opts...)
+	}
+}
+
+// StringDecimal returns a validation function that checks whether the string is a number in the number format,
+// and checks the exact parsed value with the validation functions, like StringDecimal(FormatGerman, RatPrecision(Precision{Digits: 12, Scale: 2})).
+func StringDecimal(f NumberFormat, opts ...Validate[*big.Rat]) Validate[string] {
+	return func(s string) error {
+		plain, _, err := f.parse(s)
+		if err != nil {
+			return err
+		}
+		r, ok := new(big.Rat).SetString(plain)
+		if !ok {
+			return errors.New("must be a number")
+		}
+		return Join(r, opts...)
+	}
+}
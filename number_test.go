@@ -0,0 +1,80 @@
+package please
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNumberFormatParse(t *testing.T) {
+	tests := []struct {
+		format NumberFormat
+		input  string
+		want   string
+		err    string
+	}{
+		{FormatPlain, "-1234.56", "-1234.56", ""},
+		{FormatPlain, "+5", "5", ""},
+		{FormatPlain, "1,234", "", "must be a number, unexpected ','"},
+		{FormatEnglish, "1,234,567.89", "1234567.89", ""},
+		{FormatEnglish, "1234567", "1234567", ""},
+		{FormatEnglish, "12,34", "", "must group digits by three"},
+		{FormatEnglish, "1234,567", "", "must group digits by three"},
+		{FormatEnglish, ",123", "", "must group digits by three"},
+		{FormatEnglish, "1,234.5,6", "", "must be a number, unexpected ','"},
+		{FormatGerman, "-1.234,5", "-1234.5", ""},
+		{FormatFrench, "1 234,56", "1234.56", ""},
+		{FormatSwiss, "1'234.5", "1234.5", ""},
+		{FormatPlain, "007", "", "must not have leading zeros"},
+		{NumberFormat{LeadingZeros: true}, "007", "007", ""},
+		{FormatPlain, "0", "0", ""},
+		{FormatPlain, "0.5", "0.5", ""},
+		{FormatPlain, "", "", "must be a number"},
+		{FormatPlain, "-", "", "must be a number"},
+		{FormatPlain, "3.", "", "must be a number"},
+		{FormatPlain, ".5", "", "must be a number"},
+		{NumberFormat{Group: '.'}, "1.234", "", "invalid number format: grouping separator '.' equals the decimal separator"},
+		{NumberFormat{Decimal: ',', Group: ','}, "1,234", "", "invalid number format: grouping separator ',' equals the decimal separator"},
+	}
+	for _, tt := range tests {
+		got, _, err := tt.format.parse(tt.input)
+		gotErr := ""
+		if err != nil {
+			gotErr = err.Error()
+		}
+		if got != tt.want || gotErr != tt.err {
+			t.Errorf("%+v.parse(%q) = %q, %q, want %q, %q", tt.format, tt.input, got, gotErr, tt.want, tt.err)
+		}
+	}
+}
+
+func TestStringInteger(t *testing.T) {
+	validate := StringInteger(FormatEnglish, Between[int64](-10, 2000))
+	tests := map[string]string{
+		"1,234":                "",
+		"-5":                   "",
+		"1,234.5":              "must be an integer",
+		"2,001":                "2001 must be between -10 and 2000",
+		"99999999999999999999": "must fit in int64 [-9223372036854775808, 9223372036854775807]",
+	}
+	for input, want := range tests {
+		got := ""
+		if err := validate(input); err != nil {
+			got = err.Error()
+		}
+		if got != want {
+			t.Errorf("%q: got %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestStringFloatAndDecimal(t *testing.T) {
+	if err := StringFloat(FormatGerman, Positive[float64]())("1.234,5"); err != nil {
+		t.Errorf("got %v", err)
+	}
+	if err := StringDecimal(FormatFrench, RatPrecision(Precision{Digits: 6, Scale: 2}))("1 234,567"); err == nil {
+		t.Error("1 234,567 fits the scale 2")
+	}
+	if err := StringDecimal(FormatEnglish, RatBetween(big.NewRat(0, 1), big.NewRat(1000, 1)))("1,000.00"); err != nil {
+		t.Errorf("got %v", err)
+	}
+}
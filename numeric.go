@@ -0,0 +1,181 @@
+package please
+
+import (
+	"math"
+	"unsafe"
+)
+
+// Signed is a constraint for the signed integer types.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint for the unsigned integer types.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a constraint for the integer types.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is a constraint for the floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint for the integer and floating-point types.
+type Number interface {
+	Integer | Float
+}
+
+// Positive returns a validation function that checks whether the value is greater than zero.
+func Positive[T Number]() Validate[T] {
+	return func(value T) error {
+		if !(value > 0) {
+			return errorf("%v must be positive", value)
+		}
+		return nil
+	}
+}
+
+// Negative returns a validation function that checks whether the value is less than zero.
+func Negative[T Number]() Validate[T] {
+	return func(value T) error {
+		if !(value < 0) {
+			return errorf("%v must be negative", value)
+		}
+		return nil
+	}
+}
+
+// NonNegative returns a validation function that checks whether the value is greater or equal than zero.
+func NonNegative[T Number]() Validate[T] {
+	return func(value T) error {
+		if !(value >= 0) {
+			return errorf("%v must not be negative", value)
+		}
+		return nil
+	}
+}
+
+// NonPositive returns a validation function that checks whether the value is less or equal than zero.
+func NonPositive[T Number]() Validate[T] {
+	return func(value T) error {
+		if !(value <= 0) {
+			return errorf("%v must not be positive", value)
+		}
+		return nil
+	}
+}
+
+// MultipleOf returns a validation function that checks whether the integer value is a multiple of the step.
+// Only zero is a multiple of zero step.
+func MultipleOf[T Integer](step T) Validate[T] {
+	return func(value T) error {
+		if step == 0 && value != 0 || step != 0 && value%step != 0 {
+			return errorf("%v must be a multiple of %v", value, step)
+		}
+		return nil
+	}
+}
+
+// FloatMultipleOf returns a validation function that checks whether the floating-point value is a multiple of the step,
+// allowing the absolute tolerance for the rounding errors, like FloatMultipleOf(0.01, 1e-9) for cents.
+func FloatMultipleOf[T Float](step, tolerance T) Validate[T] {
+	return func(value T) error {
+		v, s := float64(value), float64(step)
+		remainder := v
+		if s != 0 {
+			remainder = v - math.Round(v/s)*s
+		}
+		if !(math.Abs(remainder) <= float64(tolerance)) {
+			return errorf("%v must be a multiple of %v", value, step)
+		}
+		return nil
+	}
+}
+
+// Finite returns a validation function that checks whether the floating-point value is neither infinity nor NaN.
+func Finite[T Float]() Validate[T] {
+	return func(value T) error {
+		if f := float64(value); math.IsInf(f, 0) || math.IsNaN(f) {
+			return errorf("%v must be finite", value)
+		}
+		return nil
+	}
+}
+
+// NotNaN returns a validation function that checks whether the floating-point value is not NaN.
+func NotNaN[T Float]() Validate[T] {
+	return func(value T) error {
+		if math.IsNaN(float64(value)) {
+			return errorf("%v must be a number", value)
+		}
+		return nil
+	}
+}
+
+// Integral returns a validation function that checks whether the floating-point value has an integral value, like 3.0.
+func Integral[T Float]() Validate[T] {
+	return func(value T) error {
+		if f := float64(value); math.IsInf(f, 0) || f != math.Trunc(f) {
+			return errorf("%v must have an integral value", value)
+		}
+		return nil
+	}
+}
+
+// ApproxEqual returns a validation function that checks whether the floating-point value is approximately equal to the target:
+// the difference is at most the absolute tolerance or the relative tolerance of the larger magnitude.
+func ApproxEqual[T Float](target, absolute, relative T) Validate[T] {
+	return func(value T) error {
+		a, b := float64(value), float64(target)
+		tolerance := max(float64(absolute), float64(relative)*max(math.Abs(a), math.Abs(b)))
+		if !(a == b || math.Abs(a-b) <= tolerance) {
+			return errorf("%v must be approximately equal to %v", value, target)
+		}
+		return nil
+	}
+}
+
+// ULPEqual returns a validation function that checks whether the floating-point value is at most the specified number
+// of units in the last place away from the target, in the precision of the value type.
+func ULPEqual[T Float](target T, ulps uint64) Validate[T] {
+	return func(value T) error {
+		if !(ulpDistance(value, target) <= ulps) {
+			return errorf("%v must be within %d ulps of %v", value, ulps, target)
+		}
+		return nil
+	}
+}
+
+// ulpDistance returns the number of representable floating-point values between the values,
+// or the maximum uint64 value if any of them is NaN.
+func ulpDistance[T Float](x, y T) uint64 {
+	if math.IsNaN(float64(x)) || math.IsNaN(float64(y)) {
+		return math.MaxUint64
+	}
+	var a, b int64
+	if unsafe.Sizeof(x) == 4 {
+		a = orderedBits(int64(int32(math.Float32bits(float32(x)))), math.MinInt32)
+		b = orderedBits(int64(int32(math.Float32bits(float32(y)))), math.MinInt32)
+	} else {
+		a = orderedBits(int64(math.Float64bits(float64(x))), math.MinInt64)
+		b = orderedBits(int64(math.Float64bits(float64(y))), math.MinInt64)
+	}
+	if a < b {
+		a, b = b, a
+	}
+	return uint64(a) - uint64(b)
+}
+
+// orderedBits maps the sign-magnitude bits of a floating-point value to an integer with the same order as the values,
+// the minimum is the minimal integer of the bits width.
+func orderedBits(bits, minimum int64) int64 {
+	if bits < 0 {
+		return minimum - bits
+	}
+	return bits
+}
@@ -0,0 +1,109 @@
+package please
+
+import (
+	"math"
+	"testing"
+)
+
+func TestULPDistance(t *testing.T) {
+	tiny := math.SmallestNonzeroFloat64
+	negZero := math.Copysign(0, -1)
+	tests := []struct {
+		name string
+		x, y float64
+		want uint64
+	}{
+		{"equal", 1, 1, 0},
+		{"next up", 1, math.Nextafter(1, 2), 1},
+		{"next down", 1, math.Nextafter(1, 0), 1},
+		{"negative next", -1, math.Nextafter(-1, -2), 1},
+		{"negative order", math.Nextafter(-1, -2), -1, 1},
+		{"zeros", 0, negZero, 0},
+		{"negative zero and tiny", negZero, tiny, 1},
+		{"zero and negative tiny", 0, -tiny, 1},
+		{"across zero", -tiny, tiny, 2},
+		{"max and infinity", math.MaxFloat64, math.Inf(1), 1},
+		{"infinities", math.Inf(-1), math.Inf(1), 0xffe0000000000000},
+		{"nan", math.NaN(), 1, math.MaxUint64},
+		{"nan both", math.NaN(), math.NaN(), math.MaxUint64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ulpDistance(tt.x, tt.y); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+			if got := ulpDistance(tt.y, tt.x); got != tt.want {
+				t.Errorf("swapped: got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestULPDistanceFloat32(t *testing.T) {
+	tiny := float32(math.SmallestNonzeroFloat32)
+	negZero := float32(math.Copysign(0, -1))
+	tests := []struct {
+		name string
+		x, y float32
+		want uint64
+	}{
+		{"next up", 1, math.Nextafter32(1, 2), 1},
+		{"negative next", -1, math.Nextafter32(-1, -2), 1},
+		{"zeros", 0, negZero, 0},
+		{"across zero", -tiny, tiny, 2},
+		{"infinities", float32(math.Inf(-1)), float32(math.Inf(1)), 0xff000000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ulpDistance(tt.x, tt.y); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumericRules(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	nan := math.NaN()
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"positive", Positive[int]()(1), ""},
+		{"positive zero", Positive[int]()(0), "0 must be positive"},
+		{"positive nan", Positive[float64]()(nan), "NaN must be positive"},
+		{"negative zero is not negative", Negative[float64]()(negZero), "-0 must be negative"},
+		{"non-negative negative zero", NonNegative[float64]()(negZero), ""},
+		{"non-negative nan", NonNegative[float64]()(nan), "NaN must not be negative"},
+		{"non-positive", NonPositive[int8]()(-1), ""},
+		{"non-positive nan", NonPositive[float64]()(nan), "NaN must not be positive"},
+		{"multiple", MultipleOf(5)(-15), ""},
+		{"multiple zero step", MultipleOf(0)(0), ""},
+		{"not multiple zero step", MultipleOf(0)(3), "3 must be a multiple of 0"},
+		{"not multiple", MultipleOf[uint](4)(6), "6 must be a multiple of 4"},
+		{"float multiple", FloatMultipleOf(0.01, 1e-9)(0.3), ""},
+		{"float not multiple", FloatMultipleOf(0.01, 1e-9)(0.305), "0.305 must be a multiple of 0.01"},
+		{"float multiple nan", FloatMultipleOf(0.01, 1e-9)(nan), "NaN must be a multiple of 0.01"},
+		{"finite", Finite[float32]()(float32(math.Inf(1))), "+Inf must be finite"},
+		{"not nan", NotNaN[float64]()(nan), "NaN must be a number"},
+		{"integral", Integral[float64]()(-3), ""},
+		{"integral fraction", Integral[float64]()(2.5), "2.5 must have an integral value"},
+		{"integral infinity", Integral[float64]()(math.Inf(-1)), "-Inf must have an integral value"},
+		{"approx absolute", ApproxEqual(1.0, 1e-3, 0)(1.0005), ""},
+		{"approx relative", ApproxEqual(1e9, 0, 1e-6)(1e9 + 500), ""},
+		{"approx far", ApproxEqual(1.0, 1e-3, 1e-3)(1.01), "1.01 must be approximately equal to 1"},
+		{"approx infinity", ApproxEqual(math.Inf(1), 0, 0)(math.Inf(1)), ""},
+		{"approx nan", ApproxEqual(nan, 1, 1)(nan), "NaN must be approximately equal to NaN"},
+		{"ulp", ULPEqual(0.3, 1)(0.1 + 0.2), ""},
+		{"ulp zeros", ULPEqual(0.0, 0)(negZero), ""},
+		{"ulp far", ULPEqual(1.0, 1)(math.Nextafter(math.Nextafter(1, 2), 2)), "1.0000000000000004 must be within 1 ulps of 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorString(tt.err); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
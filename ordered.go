@@ -1,15 +1,12 @@
 package please
 
-import (
-	"cmp"
-	"fmt"
-)
+import "cmp"
 
 // Min returns a validation function that checks whether the value is greater or equal than the minimal value.
 func Min[T cmp.Ordered](minimal T) Validate[T] {
 	return func(value T) error {
 		if value < minimal {
-			return fmt.Errorf("%v must be greater or equal than %v", value, minimal)
+			return errorf("%v must be greater or equal than %v", value, minimal)
 		}
 		return nil
 	}
@@ -19,7 +16,7 @@ func Min[T cmp.Ordered](minimal T) Validate[T] {
 func Max[T cmp.Ordered](maximal T) Validate[T] {
 	return func(value T) error {
 		if value > maximal {
-			return fmt.Errorf("%v must be less or equal than %v", value, maximal)
+			return errorf("%v must be less or equal than %v", value, maximal)
 		}
 		return nil
 	}
@@ -31,7 +28,7 @@ func Between[T cmp.Ordered](x, y T) Validate[T] {
 		minimal := min(x, y)
 		maximal := max(x, y)
 		if value < minimal || value > maximal {
-			return fmt.Errorf("%v must be between %v and %v", value, minimal, maximal)
+			return errorf("%v must be between %v and %v", value, minimal, maximal)
 		}
 		return nil
 	}
@@ -43,8 +40,110 @@ func NotBetween[T cmp.Ordered](x, y T) Validate[T] {
 		minimal := min(x, y)
 		maximal := max(x, y)
 		if value >= minimal && value <= maximal {
-			return fmt.Errorf("%v must not be between %v and %v", value, minimal, maximal)
+			return errorf("%v must not be between %v and %v", value, minimal, maximal)
 		}
 		return nil
 	}
 }
+
+// BetweenStrict returns a validation function that checks whether the value is between the minimal and maximal values,
+// unlike Between it reports an error for reversed bounds instead of swapping them.
+func BetweenStrict[T cmp.Ordered](minimal, maximal T) Validate[T] {
+	return func(value T) error {
+		if minimal > maximal {
+			return errorf("invalid bounds: %v is greater than %v", minimal, maximal)
+		}
+		if value < minimal || value > maximal {
+			return errorf("%v must be between %v and %v", value, minimal, maximal)
+		}
+		return nil
+	}
+}
+
+// NotBetweenStrict returns a validation function that checks whether the value is not between the minimal and maximal values,
+// unlike NotBetween it reports an error for reversed bounds instead of swapping them.
+func NotBetweenStrict[T cmp.Ordered](minimal, maximal T) Validate[T] {
+	return func(value T) error {
+		if minimal > maximal {
+			return errorf("invalid bounds: %v is greater than %v", minimal, maximal)
+		}
+		if value >= minimal && value <= maximal {
+			return errorf("%v must not be between %v and %v", value, minimal, maximal)
+		}
+		return nil
+	}
+}
+
+// MinFunc returns a validation function that checks whether the value is greater or equal than the minimal value
+// using the comparison function, which returns a negative number when a < b, a positive number when a > b and zero otherwise.
+func MinFunc[T any](minimal T, compare func(a, b T) int) Validate[T] {
+	return func(value T) error {
+		if compare(value, minimal) < 0 {
+			return errorf("%v must be greater or equal than %v", value, minimal)
+		}
+		return nil
+	}
+}
+
+// MaxFunc returns a validation function that checks whether the value is less or equal than the maximal value using the comparison function.
+func MaxFunc[T any](maximal T, compare func(a, b T) int) Validate[T] {
+	return func(value T) error {
+		if compare(value, maximal) > 0 {
+			return errorf("%v must be less or equal than %v", value, maximal)
+		}
+		return nil
+	}
+}
+
+// BetweenFunc returns a validation function that checks whether the value is between the minimal and maximal values using the comparison function.
+func BetweenFunc[T any](x, y T, compare func(a, b T) int) Validate[T] {
+	minimal, maximal := x, y
+	if compare(x, y) > 0 {
+		minimal, maximal = y, x
+	}
+	return func(value T) error {
+		if compare(value, minimal) < 0 || compare(value, maximal) > 0 {
+			return errorf("%v must be between %v and %v", value, minimal, maximal)
+		}
+		return nil
+	}
+}
+
+// NotBetweenFunc returns a validation function that checks whether the value is not between the minimal and maximal values using the comparison function.
+func NotBetweenFunc[T any](x, y T, compare func(a, b T) int) Validate[T] {
+	minimal, maximal := x, y
+	if compare(x, y) > 0 {
+		minimal, maximal = y, x
+	}
+	return func(value T) error {
+		if compare(value, minimal) >= 0 && compare(value, maximal) <= 0 {
+			return errorf("%v must not be between %v and %v", value, minimal, maximal)
+		}
+		return nil
+	}
+}
+
+// Comparer is implemented by types with a Compare method, like time.Time.
+type Comparer[T any] interface {
+	Compare(other T) int
+}
+
+// MinCompare returns a validation function that checks whether the value is greater or equal than the minimal value using the Compare method.
+func MinCompare[T Comparer[T]](minimal T) Validate[T] {
+	return MinFunc(minimal, T.Compare)
+}
+
+// MaxCompare returns a validation function that checks whether the value is less or equal than the maximal value using the Compare method.
+func MaxCompare[T Comparer[T]](maximal T) Validate[T] {
+	return MaxFunc(maximal, T.Compare)
+}
+
+// BetweenCompare returns a validation function that checks whether the value is between the minimal and maximal values using the Compare method.
+func BetweenCompare[T Comparer[T]](x, y T) Validate[T] {
+	return BetweenFunc(x, y, T.Compare)
+}
+
+// NotBetweenCompare returns a validation function that checks whether the value is not between the minimal and maximal values using the Compare method.
+func NotBetweenCompare[T Comparer[T]](x, y T) Validate[T] {
+	return NotBetweenFunc(x, y, T.Compare)
+}
@@ -0,0 +1,80 @@
+package please
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// version is a type without the ordered operators compared by its Compare method.
+type version struct {
+	major, minor int
+}
+
+func (v version) Compare(other version) int {
+	if v.major != other.major {
+		return v.major - other.major
+	}
+	return v.minor - other.minor
+}
+
+func (v version) String() string {
+	return fmt.Sprintf("v%d.%d", v.major, v.minor)
+}
+
+func TestOrderedFunc(t *testing.T) {
+	byLen := func(a, b string) int { return len(a) - len(b) }
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"min equal", MinFunc("abc", byLen)("xyz"), ""},
+		{"min below", MinFunc("abc", byLen)("xy"), "xy must be greater or equal than abc"},
+		{"max equal", MaxFunc("abc", byLen)("xyz"), ""},
+		{"max above", MaxFunc("abc", byLen)("wxyz"), "wxyz must be less or equal than abc"},
+		{"between bounds", BetweenFunc("a", "abc", byLen)("ab"), ""},
+		{"between swapped", BetweenFunc("abc", "a", byLen)("xyz"), ""},
+		{"between outside", BetweenFunc("abc", "a", byLen)(""), " must be between a and abc"},
+		{"not between outside", NotBetweenFunc("abc", "a", byLen)("wxyz"), ""},
+		{"not between inside", NotBetweenFunc("abc", "a", byLen)("x"), "x must not be between a and abc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorString(tt.err); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedCompare(t *testing.T) {
+	v1, v2, v3 := version{1, 0}, version{1, 2}, version{2, 0}
+	noon := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"min", MinCompare(v2)(v3), ""},
+		{"min below", MinCompare(v2)(v1), "v1.0 must be greater or equal than v1.2"},
+		{"max", MaxCompare(v2)(v2), ""},
+		{"max above", MaxCompare(v2)(v3), "v2.0 must be less or equal than v1.2"},
+		{"between swapped", BetweenCompare(v3, v1)(v2), ""},
+		{"between outside", BetweenCompare(v2, v3)(v1), "v1.0 must be between v1.2 and v2.0"},
+		{"not between", NotBetweenCompare(v2, v3)(v1), ""},
+		{"not between inside", NotBetweenCompare(v3, v1)(v2), "v1.2 must not be between v1.0 and v2.0"},
+		// Times in different locations are compared as instants.
+		{"time", BetweenCompare(noon, noon)(noon.In(time.FixedZone("UTC+5", 5*60*60))), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorString(tt.err); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if MaxCompare(noon)(noon.Add(time.Nanosecond)) == nil {
+		t.Error("later time passed MaxCompare")
+	}
+}
@@ -0,0 +1,189 @@
+package please
+
+import (
+	"errors"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+// StringPolicy is a set of string constraints that are checked in a single pass over the string.
+// Each constraint reports the same error as the corresponding String* validation function, zero limits mean no limit.
+type StringPolicy struct {
+	// UTF8 requires the string to be a valid UTF-8 string, like StringUTF8.
+	UTF8 bool
+
+	// MinLen and MaxLen limit the length of the string in bytes, like StringMinLen and StringMaxLen.
+	MinLen, MaxLen int
+	// MinRuneCount and MaxRuneCount limit the number of runes, like StringMinRuneCount and StringMaxRuneCount.
+	MinRuneCount, MaxRuneCount int
+	// MinUniqueRuneCount and MaxUniqueRuneCount limit the number of unique runes, like StringMinUniqueRuneCount and StringMaxUniqueRuneCount.
+	MinUniqueRuneCount, MaxUniqueRuneCount int
+
+	// Numeric requires only numeric characters, like StringNumeric.
+	Numeric bool
+	// Alpha requires only alphabet characters, like StringAlpha.
+	Alpha bool
+	// AlphaNumeric requires only alphanumeric characters, like StringAlphaNumeric.
+	AlphaNumeric bool
+	// PrintableASCII requires only ASCII printable characters, like StringPrintableASCII.
+	PrintableASCII bool
+	// UnicodeLetters requires only unicode letters, like StringUnicodeLetters.
+	UnicodeLetters bool
+	// UnicodeDigits requires only unicode digits, like StringUnicodeDigits.
+	UnicodeDigits bool
+
+	// Allow is the charset of allowed characters, like StringAllow.
+	Allow string
+	// NotAllow is the charset of disallowed characters, like StringNotAllow.
+	NotAllow string
+	// ContainsAny is the list of charsets the string must contain at least one character of, like StringContainsAny.
+	ContainsAny []string
+}
+
+// stringScan is the result of scanning a string against the character constraints of a policy.
+type stringScan struct {
+	invalidUTF8    bool
+	runeCount      int
+	unique         runeSet
+	numeric        bool
+	alpha          bool
+	alphaNumeric   bool
+	printableASCII bool
+	unicodeLetters bool
+	unicodeDigits  bool
+	allowed        bool
+	notAllowed     bool
+	found          uint64
+	foundMore      []bool
+}
+
+// Compile returns a validation function that checks all constraints of the policy in a single pass over the string
+// and reports every violated constraint.
+func (p StringPolicy) Compile() Validate[string] {
+	allow := CharsetOf(p.Allow)
+	notAllow := CharsetOf(p.NotAllow)
+	containsAny := make([]Charset, 0, len(p.ContainsAny))
+	for _, charset := range p.ContainsAny {
+		containsAny = append(containsAny, CharsetOf(charset))
+	}
+	p.ContainsAny = slices.Clone(p.ContainsAny)
+	countUnique := p.MinUniqueRuneCount > 0 || p.MaxUniqueRuneCount > 0
+	ascii := p.Numeric || p.Alpha || p.AlphaNumeric || p.PrintableASCII
+	return func(s string) error {
+		scan := stringScan{
+			numeric:        true,
+			alpha:          true,
+			alphaNumeric:   true,
+			printableASCII: true,
+			unicodeLetters: true,
+			unicodeDigits:  true,
+			allowed:        true,
+		}
+		if len(p.ContainsAny) > 64 {
+			scan.foundMore = make([]bool, len(p.ContainsAny))
+		}
+		for i := 0; i < len(s); {
+			char, size := utf8.DecodeRuneInString(s[i:])
+			i += size
+			if char == utf8.RuneError && size == 1 {
+				scan.invalidUTF8 = true
+			}
+			scan.runeCount++
+			if countUnique {
+				scan.unique.add(char)
+			}
+			if ascii {
+				digit := char >= '0' && char <= '9'
+				letter := char >= 'A' && char <= 'Z' || char >= 'a' && char <= 'z'
+				scan.numeric = scan.numeric && (!p.Numeric || digit)
+				scan.alpha = scan.alpha && (!p.Alpha || letter)
+				scan.alphaNumeric = scan.alphaNumeric && (!p.AlphaNumeric || digit || letter)
+				scan.printableASCII = scan.printableASCII && (!p.PrintableASCII || char >= 33 && char <= 126)
+			}
+			if p.UnicodeLetters && scan.unicodeLetters {
+				scan.unicodeLetters = unicode.IsLetter(char)
+			}
+			if p.UnicodeDigits && scan.unicodeDigits {
+				scan.unicodeDigits = unicode.IsDigit(char)
+			}
+			if p.Allow != "" && scan.allowed {
+				scan.allowed = allow.Contains(char)
+			}
+			if p.NotAllow != "" && !scan.notAllowed {
+				scan.notAllowed = notAllow.Contains(char)
+			}
+			for k, charset := range containsAny {
+				if !charset.Contains(char) {
+					continue
+				}
+				if k < 64 {
+					scan.found |= 1 << k
+				} else {
+					scan.foundMore[k] = true
+				}
+			}
+		}
+		return p.report(s, &scan)
+	}
+}
+
+// report returns the violated constraints of the policy as a joined error.
+func (p StringPolicy) report(s string, scan *stringScan) error {
+	var errs []error
+	if p.UTF8 && scan.invalidUTF8 {
+		errs = append(errs, errorf("must be utf-8 valid string"))
+	}
+	if p.MinLen > 0 && len(s) < p.MinLen {
+		errs = append(errs, errorf("must contain at least %d characters", p.MinLen))
+	}
+	if p.MaxLen > 0 && len(s) > p.MaxLen {
+		errs = append(errs, errorf("must contain at most %d characters", p.MaxLen))
+	}
+	if p.MinRuneCount > 0 && scan.runeCount < p.MinRuneCount {
+		errs = append(errs, errorf("must contain at least %d characters", p.MinRuneCount))
+	}
+	if p.MaxRuneCount > 0 && scan.runeCount > p.MaxRuneCount {
+		errs = append(errs, errorf("must contain at most %d characters", p.MaxRuneCount))
+	}
+	if p.MinUniqueRuneCount > 0 && scan.unique.count < p.MinUniqueRuneCount {
+		errs = append(errs, errorf("must contain at least %d unique characters", p.MinUniqueRuneCount))
+	}
+	if p.MaxUniqueRuneCount > 0 && scan.unique.count > p.MaxUniqueRuneCount {
+		errs = append(errs, errorf("must contain at most %d unique characters", p.MaxUniqueRuneCount))
+	}
+	if p.Numeric && !scan.numeric {
+		errs = append(errs, errors.New("must contain only numeric characters"))
+	}
+	if p.Alpha && !scan.alpha {
+		errs = append(errs, errors.New("must contain only alphabet characters"))
+	}
+	if p.AlphaNumeric && !scan.alphaNumeric {
+		errs = append(errs, errors.New("must contain only alphanumeric characters"))
+	}
+	if p.PrintableASCII && !scan.printableASCII {
+		errs = append(errs, errors.New("must contain only ascii characters"))
+	}
+	if p.UnicodeLetters && !scan.unicodeLetters {
+		errs = append(errs, errors.New("must contain only unicode letters"))
+	}
+	if p.UnicodeDigits && !scan.unicodeDigits {
+		errs = append(errs, errors.New("must contain only unicode digits"))
+	}
+	if !scan.allowed {
+		errs = append(errs, errorf("must contain only allowed characters: %q", p.Allow))
+	}
+	if scan.notAllowed {
+		errs = append(errs, errorf("must not contain disallowed characters: %q", p.NotAllow))
+	}
+	for k, charset := range p.ContainsAny {
+		found := scan.found&(1<<k) != 0
+		if k >= 64 {
+			found = scan.foundMore[k]
+		}
+		if !found {
+			errs = append(errs, errorf("must contain one of characters: %q", charset))
+		}
+	}
+	return errors.Join(errs...)
+}
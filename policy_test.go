@@ -0,0 +1,95 @@
+package please
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// policyRules returns the individual validation functions that correspond to the constraints of the policy.
+func policyRules(p StringPolicy) []Validate[string] {
+	var rules []Validate[string]
+	add := func(enabled bool, rule Validate[string]) {
+		if enabled {
+			rules = append(rules, rule)
+		}
+	}
+	add(p.UTF8, StringUTF8())
+	add(p.MinLen > 0, StringMinLen(p.MinLen))
+	add(p.MaxLen > 0, StringMaxLen(p.MaxLen))
+	add(p.MinRuneCount > 0, StringMinRuneCount(p.MinRuneCount))
+	add(p.MaxRuneCount > 0, StringMaxRuneCount(p.MaxRuneCount))
+	add(p.MinUniqueRuneCount > 0, StringMinUniqueRuneCount(p.MinUniqueRuneCount))
+	add(p.MaxUniqueRuneCount > 0, StringMaxUniqueRuneCount(p.MaxUniqueRuneCount))
+	add(p.Numeric, StringNumeric())
+	add(p.Alpha, StringAlpha())
+	add(p.AlphaNumeric, StringAlphaNumeric())
+	add(p.PrintableASCII, StringPrintableASCII())
+	add(p.UnicodeLetters, StringUnicodeLetters())
+	add(p.UnicodeDigits, StringUnicodeDigits())
+	add(p.Allow != "", StringAllow(p.Allow))
+	add(p.NotAllow != "", StringNotAllow(p.NotAllow))
+	for _, charset := range p.ContainsAny {
+		add(true, StringContainsAny(charset))
+	}
+	return rules
+}
+
+func TestStringPolicyMatchesRules(t *testing.T) {
+	manySets := make([]string, 70)
+	for i := range manySets {
+		manySets[i] = string(rune('0' + i%10))
+	}
+	manySets[69] = "ж"
+	var wide strings.Builder
+	for i := 0; i < 40; i++ {
+		wide.WriteRune('а' + rune(i%32))
+		wide.WriteRune('A' + rune(i%26))
+	}
+
+	policies := []StringPolicy{
+		{UTF8: true, MinLen: 3, MaxLen: 8, MinRuneCount: 2, MaxRuneCount: 6},
+		{MinUniqueRuneCount: 3, MaxUniqueRuneCount: 5},
+		{MinUniqueRuneCount: 40, MaxUniqueRuneCount: 50},
+		{Numeric: true, Alpha: true, AlphaNumeric: true, PrintableASCII: true},
+		{UnicodeLetters: true, UnicodeDigits: true},
+		{Allow: "abc123", NotAllow: "x!", ContainsAny: []string{"abc", "123", "ж"}},
+		{ContainsAny: manySets},
+		{UTF8: true, AlphaNumeric: true, MaxUniqueRuneCount: 4, Allow: "ab\xff", ContainsAny: []string{"b"}},
+	}
+	inputs := []string{
+		"",
+		"a",
+		"abc",
+		"abc123",
+		"hello world!",
+		"ab\xffcd",
+		"\xff\xfe",
+		"жжж123",
+		"0123456789",
+		"0123456789ж",
+		"١٢٣",
+		"xyz!",
+		wide.String(),
+		strings.Repeat("ab", 100),
+	}
+	for i, p := range policies {
+		compiled := p.Compile()
+		rules := policyRules(p)
+		for _, s := range inputs {
+			got, want := compiled(s), Join(s, rules...)
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("policy %d, input %q:\ngot  %v\nwant %v", i, s, got, want)
+			}
+		}
+	}
+}
+
+func TestStringPolicyAllocations(t *testing.T) {
+	validate := StringPolicy{
+		UTF8: true, MinLen: 3, MaxLen: 64, MinUniqueRuneCount: 3, AlphaNumeric: true, ContainsAny: []string{"0123456789"},
+	}.Compile()
+	if allocs := testing.AllocsPerRun(100, func() { _ = validate("abc123") }); allocs != 0 {
+		t.Errorf("got %v allocations, want 0", allocs)
+	}
+}
@@ -0,0 +1,12 @@
+package report
+
+import "encoding/json"
+
+// JSON returns the validation errors as a JSON array of objects with path and message fields.
+func JSON(err error) ([]byte, error) {
+	list := entries(err)
+	if list == nil {
+		list = []entry{}
+	}
+	return json.Marshal(list)
+}
@@ -0,0 +1,25 @@
+package report
+
+import "strings"
+
+// markdownEscaper escapes the characters that break a Markdown table cell.
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+// Markdown returns the validation errors as a Markdown table with field and error columns.
+func Markdown(err error) string {
+	list := entries(err)
+	if len(list) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("| Field | Error |\n")
+	b.WriteString("| --- | --- |\n")
+	for _, e := range list {
+		field := ""
+		if e.Path != "" {
+			field = "`" + strings.ReplaceAll(e.Path, "`", "'") + "`"
+		}
+		b.WriteString("| " + markdownEscaper.Replace(field) + " | " + markdownEscaper.Replace(e.Message) + " |\n")
+	}
+	return b.String()
+}
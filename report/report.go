@@ -0,0 +1,124 @@
+// Package report renders validation errors as human and machine readable reports.
+package report
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/zhassymov/please"
+)
+
+// entry is a single validation error message with the path of the field it belongs to.
+type entry struct {
+	Path        string   `json:"path"`
+	Message     string   `json:"message"`
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+// group is a list of validation error messages that belong to the same field path.
+type group struct {
+	Path     string
+	Messages []string
+}
+
+// entries extracts the violations from the error tree and returns them in deterministic order.
+func entries(err error) []entry {
+	violations := please.Violations(err)
+	if len(violations) == 0 {
+		return nil
+	}
+	list := make([]entry, 0, len(violations))
+	for _, v := range violations {
+		list = append(list, entry{Path: v.Path, Message: v.Message, Suggestions: v.Suggestions})
+	}
+	slices.SortStableFunc(list, func(a, b entry) int {
+		return comparePaths(a.Path, b.Path)
+	})
+	return list
+}
+
+// groups walks the error tree and returns the validation error messages grouped by field path.
+func groups(err error) []group {
+	var list []group
+	for _, e := range entries(err) {
+		if n := len(list); n > 0 && list[n-1].Path == e.Path {
+			list[n-1].Messages = append(list[n-1].Messages, e.Message)
+			continue
+		}
+		list = append(list, group{Path: e.Path, Messages: []string{e.Message}})
+	}
+	return list
+}
+
+// comparePaths compares field paths, ordering collection indexes numerically.
+func comparePaths(a, b string) int {
+	for a != "" && b != "" {
+		x, restA := token(a)
+		y, restB := token(b)
+		if c := compareTokens(x, y); c != 0 {
+			return c
+		}
+		a, b = restA, restB
+	}
+	return len(a) - len(b)
+}
+
+// token splits the path into the leading run of digits or non-digits and the rest of the path.
+func token(s string) (string, string) {
+	digit := isDigit(s[0])
+	i := 1
+	for i < len(s) && isDigit(s[i]) == digit {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+// compareTokens compares path tokens, numerically if both of them are numbers.
+func compareTokens(x, y string) int {
+	if isDigit(x[0]) && isDigit(y[0]) {
+		n, errX := strconv.ParseUint(x, 10, 64)
+		m, errY := strconv.ParseUint(y, 10, 64)
+		if errX == nil && errY == nil && n != m {
+			if n < m {
+				return -1
+			}
+			return 1
+		}
+	}
+	return strings.Compare(x, y)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// style is the formatting of the field paths and message bullets of a text report.
+type style struct {
+	path   func(path string) string
+	bullet string
+}
+
+// plain is the style of the plain text report.
+var plain = style{path: func(path string) string { return path }, bullet: "-"}
+
+// text returns the validation errors as a text report grouped by field path in the style.
+func text(err error, s style) string {
+	var b strings.Builder
+	for _, g := range groups(err) {
+		indent := ""
+		if g.Path != "" {
+			b.WriteString(s.path(g.Path) + ":\n")
+			indent = "  "
+		}
+		for _, msg := range g.Messages {
+			b.WriteString(indent + s.bullet + " " + strings.ReplaceAll(msg, "\n", "\n"+indent+"  ") + "\n")
+		}
+	}
+	return b.String()
+}
+
+// Text returns the validation errors as a plain text report grouped by field path.
+func Text(err error) string {
+	return text(err, plain)
+}
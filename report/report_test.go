@@ -0,0 +1,119 @@
+package report
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/zhassymov/please"
+)
+
+// sample returns an error tree with nested paths, indexes and a root error.
+func sample() error {
+	return errors.Join(
+		&please.FieldError{Field: "items", Err: errors.Join(
+			&please.FieldError{Field: please.Index(10), Err: errors.New("must be positive")},
+			&please.FieldError{Field: please.Index(2), Err: errors.New("must be positive")},
+		)},
+		&please.FieldError{Field: "name", Err: errors.Join(errors.New("too short"), errors.New("must be alphanumeric"))},
+		errors.New("root error"),
+	)
+}
+
+func TestComparePaths(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"items[2]", "items[10]", -1},
+		{"items[10]", "items[2]", 1},
+		{"items[2].name", "items[2].name", 0},
+		{"a", "a.b", -1},
+		{"", "a", -1},
+		{"users[1].tags[10]", "users[1].tags[9]", 1},
+		{"name", "email", 1},
+	}
+	for _, tt := range tests {
+		got := comparePaths(tt.a, tt.b)
+		if got < 0 {
+			got = -1
+		} else if got > 0 {
+			got = 1
+		}
+		if got != tt.want {
+			t.Errorf("comparePaths(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	want := `- root error
+items[2]:
+  - must be positive
+items[10]:
+  - must be positive
+name:
+  - too short
+  - must be alphanumeric
+`
+	if got := Text(sample()); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	if got := Text(nil); got != "" {
+		t.Errorf("got %q for nil error", got)
+	}
+}
+
+func TestTextMultilineMessage(t *testing.T) {
+	err := &please.FieldError{Field: "a", Err: errors.New("first\nsecond")}
+	want := "a:\n  - first\n    second\n"
+	if got := Text(err); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestTerminal(t *testing.T) {
+	got := Terminal(sample())
+	if !strings.Contains(got, ansiBold+ansiCyan+"items[2]"+ansiReset+":\n") {
+		t.Errorf("got %q, want a colored path", got)
+	}
+	if !strings.Contains(got, "  "+ansiRed+"✗"+ansiReset+" too short\n") {
+		t.Errorf("got %q, want a colored bullet", got)
+	}
+	plainText := strings.NewReplacer(ansiBold, "", ansiCyan, "", ansiRed, "", ansiReset, "", "✗", "-").Replace(got)
+	if plainText != Text(sample()) {
+		t.Errorf("got %q, want the text report with colors", got)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	got, err := JSON(nil)
+	if err != nil || string(got) != "[]" {
+		t.Errorf("got %s, %v, want []", got, err)
+	}
+	got, err = JSON(&please.FieldError{Field: "status", Err: please.OneOf("active", "pending")("pendng")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"path":"status","message":"pendng must be one of [active pending], did you mean \"pending\"?","suggestions":["pending"]}]`
+	if string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	err := errors.Join(
+		&please.FieldError{Field: "a|b", Err: errors.New("must not contain |")},
+		errors.New("first line\nsecond line"),
+	)
+	want := "| Field | Error |\n" +
+		"| --- | --- |\n" +
+		"|  | first line<br>second line |\n" +
+		"| `a\\|b` | must not contain \\| |\n"
+	if got := Markdown(err); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	if got := Markdown(nil); got != "" {
+		t.Errorf("got %q for nil error", got)
+	}
+}
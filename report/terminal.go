@@ -0,0 +1,20 @@
+package report
+
+// ANSI escape sequences used by the terminal report.
+const (
+	ansiReset = "\x1b[0m"
+	ansiBold  = "\x1b[1m"
+	ansiRed   = "\x1b[31m"
+	ansiCyan  = "\x1b[36m"
+)
+
+// colored is the style of the terminal report.
+var colored = style{
+	path:   func(path string) string { return ansiBold + ansiCyan + path + ansiReset },
+	bullet: ansiRed + "✗" + ansiReset,
+}
+
+// Terminal returns the validation errors as a text report grouped by field path and colored with ANSI escape sequences.
+func Terminal(err error) string {
+	return text(err, colored)
+}
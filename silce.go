@@ -1,16 +1,12 @@
 package please
 
-import (
-	"errors"
-	"fmt"
-	"slices"
-)
+import "slices"
 
 // SliceLen returns a validation function that checks whether the length of the slice is equal to the specified number.
 func SliceLen[S ~[]E, E any](n int) Validate[S] {
 	return func(s S) error {
 		if len(s) != n {
-			return fmt.Errorf("length must be equal %d", n)
+			return errorf("length must be equal %d", n)
 		}
 		return nil
 	}
@@ -20,7 +16,7 @@ func SliceLen[S ~[]E, E any](n int) Validate[S] {
 func SliceMinLen[S ~[]E, E any](n int) Validate[S] {
 	return func(s S) error {
 		if len(s) < n {
-			return fmt.Errorf("length must be at least %d", n)
+			return errorf("length must be at least %d", n)
 		}
 		return nil
 	}
@@ -30,7 +26,7 @@ func SliceMinLen[S ~[]E, E any](n int) Validate[S] {
 func SliceMaxLen[S ~[]E, E any](n int) Validate[S] {
 	return func(s S) error {
 		if len(s) > n {
-			return fmt.Errorf("length must be at most %d", n)
+			return errorf("length must be at most %d", n)
 		}
 		return nil
 	}
@@ -42,7 +38,7 @@ func SliceLenBetween[S ~[]E, E any](x, y int) Validate[S] {
 		minimal := min(x, y)
 		maximal := max(x, y)
 		if len(s) < minimal || len(s) > maximal {
-			return fmt.Errorf("length must be between %d and %d", minimal, maximal)
+			return errorf("length must be between %d and %d", minimal, maximal)
 		}
 		return nil
 	}
@@ -54,7 +50,7 @@ func SliceLenNotBetween[S ~[]E, E any](x, y int) Validate[S] {
 		minimal := min(x, y)
 		maximal := max(x, y)
 		if len(s) >= minimal && len(s) <= maximal {
-			return fmt.Errorf("length must not be between %d and %d", minimal, maximal)
+			return errorf("length must not be between %d and %d", minimal, maximal)
 		}
 		return nil
 	}
@@ -64,7 +60,7 @@ func SliceLenNotBetween[S ~[]E, E any](x, y int) Validate[S] {
 func SliceContain[S ~[]E, E comparable](value E) Validate[S] {
 	return func(s S) error {
 		if !slices.Contains(s, value) {
-			return fmt.Errorf("%v must contain %v", s, value)
+			return errorf("%v must contain %v", s, value)
 		}
 		return nil
 	}
@@ -74,7 +70,7 @@ func SliceContain[S ~[]E, E comparable](value E) Validate[S] {
 func SliceNotContain[S ~[]E, E comparable](value E) Validate[S] {
 	return func(s S) error {
 		if slices.Contains(s, value) {
-			return fmt.Errorf("%v must not contain %v", s, value)
+			return errorf("%v must not contain %v", s, value)
 		}
 		return nil
 	}
@@ -82,13 +78,5 @@ func SliceNotContain[S ~[]E, E comparable](value E) Validate[S] {
 
 // SliceEach returns a validation function that checks whether each element in the slice satisfies the specified validation functions.
 func SliceEach[S ~[]E, E any](opts ...Validate[E]) Validate[S] {
-	return func(s S) error {
-		errs := make([]error, 0, len(opts))
-		for i := range s {
-			if err := Join(s[i], opts...); err != nil {
-				errs = append(errs, err)
-			}
-		}
-		return errors.Join(errs...)
-	}
+	return SliceEachBudget[S](Budget{}, opts...)
 }
@@ -0,0 +1,143 @@
+package please
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ByteSize is a number of bytes, like 512*Mebibyte.
+type ByteSize uint64
+
+// Byte sizes of the SI and IEC units.
+const (
+	Byte ByteSize = 1
+
+	Kilobyte ByteSize = 1000 * Byte
+	Megabyte ByteSize = 1000 * Kilobyte
+	Gigabyte ByteSize = 1000 * Megabyte
+	Terabyte ByteSize = 1000 * Gigabyte
+	Petabyte ByteSize = 1000 * Terabyte
+	Exabyte  ByteSize = 1000 * Petabyte
+
+	Kibibyte ByteSize = 1024 * Byte
+	Mebibyte ByteSize = 1024 * Kibibyte
+	Gibibyte ByteSize = 1024 * Mebibyte
+	Tebibyte ByteSize = 1024 * Gibibyte
+	Pebibyte ByteSize = 1024 * Tebibyte
+	Exbibyte ByteSize = 1024 * Pebibyte
+)
+
+// byteUnit is a unit of the byte size.
+type byteUnit struct {
+	name string
+	size ByteSize
+}
+
+// byteUnits are the byte size units from the largest to the smallest, the IEC unit before the SI unit of the same prefix.
+var byteUnits = []byteUnit{
+	{"EiB", Exbibyte}, {"EB", Exabyte},
+	{"PiB", Pebibyte}, {"PB", Petabyte},
+	{"TiB", Tebibyte}, {"TB", Terabyte},
+	{"GiB", Gibibyte}, {"GB", Gigabyte},
+	{"MiB", Mebibyte}, {"MB", Megabyte},
+	{"KiB", Kibibyte}, {"kB", Kilobyte},
+	{"B", Byte},
+}
+
+// String returns the byte size in the largest unit that represents it exactly with up to two fractional digits,
+// like "512MiB" or "1.5GB", or in bytes otherwise, like "1234567B".
+func (b ByteSize) String() string {
+	for _, u := range byteUnits[:len(byteUnits)-1] {
+		// The size is exact in hundredths of the unit if it is a multiple of unit/gcd(unit, 100).
+		step := u.size / ByteSize(new(big.Int).GCD(nil, nil, new(big.Int).SetUint64(uint64(u.size)), big.NewInt(100)).Uint64())
+		if b >= u.size && b%step == 0 {
+			return formatByteSize(b, u)
+		}
+	}
+	return strconv.FormatUint(uint64(b), 10) + "B"
+}
+
+// formatByteSize returns the byte size in the unit with up to two fractional digits.
+func formatByteSize(b ByteSize, u byteUnit) string {
+	s := strconv.FormatFloat(float64(b)/float64(u.size), 'f', 2, 64)
+	s = strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+	return s + u.name
+}
+
+// ambiguousByteUnit returns the SI and IEC units for the ambiguous unit, like "kB" and "KiB" for "KB",
+// or nil if the unit is not ambiguous.
+func ambiguousByteUnit(unit string) []string {
+	if unit == "b" {
+		return []string{"B"}
+	}
+	prefix, rest := strings.ToUpper(unit[:1]), unit[1:]
+	if !strings.Contains("KMGTPE", prefix) || !(rest == "" || strings.EqualFold(rest, "B") || strings.EqualFold(rest, "iB")) {
+		return nil
+	}
+	si := prefix + "B"
+	if prefix == "K" {
+		si = "kB"
+	}
+	return []string{si, prefix + "iB"}
+}
+
+// ParseByteSize parses the byte size with an optional SI or IEC unit, like "512MiB", "1.5 GB" or "100".
+// Ambiguous units like "K", "KB" or "mb" are rejected, so are fractions of a byte and sizes that overflow uint64.
+func ParseByteSize(s string) (ByteSize, error) {
+	i := 0
+	for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+		i++
+	}
+	number, unit := s[:i], strings.TrimPrefix(s[i:], " ")
+	r, err := parseDecimal(number, false)
+	if err != nil || number[0] == '.' {
+		return 0, errors.New(`must be a byte size, like "512MiB"`)
+	}
+	size := Byte
+	if unit != "" {
+		found := false
+		for _, u := range byteUnits {
+			if u.name == unit {
+				size, found = u.size, true
+				break
+			}
+		}
+		if !found {
+			if units := ambiguousByteUnit(unit); units != nil {
+				return 0, suggest(errorf("ambiguous byte size unit %q", unit), units)
+			}
+			return 0, suggest(errorf("unknown byte size unit %q", unit), Suggest(unit, byteUnitNames()...))
+		}
+	}
+	r.Mul(r, new(big.Rat).SetUint64(uint64(size)))
+	if !r.IsInt() {
+		return 0, errors.New("must be a whole number of bytes")
+	}
+	if n := r.Num(); !n.IsUint64() {
+		return 0, errors.New("must be less than 16EiB")
+	}
+	return ByteSize(r.Num().Uint64()), nil
+}
+
+// StringByteSize returns a validation function that checks whether the string is a byte size like "512MiB" or "1.5GB",
+// and checks the parsed size with the validation functions, like StringByteSize(Max(4*Gibibyte)).
+func StringByteSize(opts ...Validate[ByteSize]) Validate[string] {
+	return func(s string) error {
+		b, err := ParseByteSize(s)
+		if err != nil {
+			return err
+		}
+		return Join(b, opts...)
+	}
+}
+
+// byteUnitNames returns the names of the byte size units.
+func byteUnitNames() []string {
+	names := make([]string, 0, len(byteUnits))
+	for _, u := range byteUnits {
+		names = append(names, u.name)
+	}
+	return names
+}
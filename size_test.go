@@ -0,0 +1,91 @@
+package please
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		input string
+		want  ByteSize
+		err   string
+	}{
+		{"512MiB", 512 * Mebibyte, ""},
+		{"1.5 GB", 1500 * Megabyte, ""},
+		{"100", 100, ""},
+		{"0", 0, ""},
+		{"10 GiB", 10 * Gibibyte, ""},
+		{"15EiB", 15 * Exbibyte, ""},
+		{"18446744073709551615B", 1<<64 - 1, ""},
+		{"16EiB", 0, "must be less than 16EiB"},
+		{"18446744073709551616", 0, "must be less than 16EiB"},
+		{"1.0005kB", 0, "must be a whole number of bytes"},
+		{"-1MB", 0, `must be a byte size, like "512MiB"`},
+		{".5MiB", 0, `must be a byte size, like "512MiB"`},
+		{"5.MiB", 0, `must be a byte size, like "512MiB"`},
+		{"MiB", 0, `must be a byte size, like "512MiB"`},
+		{"10XB", 0, `unknown byte size unit "XB", did you mean "EB" or "PB" or "TB"?`},
+	}
+	for _, tt := range tests {
+		got, err := ParseByteSize(tt.input)
+		gotErr := ""
+		if err != nil {
+			gotErr = err.Error()
+		}
+		if got != tt.want || gotErr != tt.err {
+			t.Errorf("ParseByteSize(%q) = %d, %q, want %d, %q", tt.input, got, gotErr, tt.want, tt.err)
+		}
+	}
+}
+
+func TestParseByteSizeAmbiguousUnits(t *testing.T) {
+	tests := map[string]string{
+		"10K":   `ambiguous byte size unit "K", did you mean "kB" or "KiB"?`,
+		"10KB":  `ambiguous byte size unit "KB", did you mean "kB" or "KiB"?`,
+		"10mb":  `ambiguous byte size unit "mb", did you mean "MB" or "MiB"?`,
+		"10Gib": `ambiguous byte size unit "Gib", did you mean "GB" or "GiB"?`,
+		"10b":   `ambiguous byte size unit "b", did you mean "B"?`,
+	}
+	for input, want := range tests {
+		if _, err := ParseByteSize(input); err == nil || err.Error() != want {
+			t.Errorf("ParseByteSize(%q) error = %v, want %q", input, err, want)
+		}
+	}
+}
+
+func TestByteSizeString(t *testing.T) {
+	tests := []struct {
+		size ByteSize
+		want string
+	}{
+		{0, "0B"},
+		{1023, "1023B"},
+		{Kibibyte, "1KiB"},
+		{1536, "1.5KiB"},
+		{Kilobyte, "1kB"},
+		{1500, "1.5kB"},
+		{1234567, "1234567B"},
+		{512 * Mebibyte, "512MiB"},
+		{15 * Exbibyte, "15EiB"},
+		{1 << 63, "8EiB"},
+		{1<<64 - 1, "18446744073709551615B"},
+	}
+	for _, tt := range tests {
+		if got := tt.size.String(); got != tt.want {
+			t.Errorf("ByteSize(%d).String() = %q, want %q", uint64(tt.size), got, tt.want)
+		}
+	}
+}
+
+func TestStringByteSizeAndDuration(t *testing.T) {
+	if err := StringByteSize(Between(Kibibyte, 4*Gibibyte))("5GiB"); err == nil || err.Error() != "5GiB must be between 1KiB and 4GiB" {
+		t.Errorf("got %v", err)
+	}
+	if err := StringDuration(Between(time.Second, time.Minute))("90s"); err == nil || err.Error() != "1m30s must be between 1s and 1m0s" {
+		t.Errorf("got %v", err)
+	}
+	if err := StringDuration()("5 s"); err == nil || err.Error() != `must be a duration, like "1h30m"` {
+		t.Errorf("got %v", err)
+	}
+}
@@ -2,7 +2,7 @@ package please
 
 import (
 	"errors"
-	"fmt"
+	"slices"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -12,7 +12,7 @@ import (
 func StringLen(n int) Validate[string] {
 	return func(s string) error {
 		if len(s) != n {
-			return fmt.Errorf("must contain exactly %d characters", n)
+			return errorf("must contain exactly %d characters", n)
 		}
 		return nil
 	}
@@ -22,7 +22,7 @@ func StringLen(n int) Validate[string] {
 func StringMinLen(n int) Validate[string] {
 	return func(s string) error {
 		if len(s) < n {
-			return fmt.Errorf("must contain at least %d characters", n)
+			return errorf("must contain at least %d characters", n)
 		}
 		return nil
 	}
@@ -32,7 +32,7 @@ func StringMinLen(n int) Validate[string] {
 func StringMaxLen(n int) Validate[string] {
 	return func(s string) error {
 		if len(s) > n {
-			return fmt.Errorf("must contain at most %d characters", n)
+			return errorf("must contain at most %d characters", n)
 		}
 		return nil
 	}
@@ -44,7 +44,7 @@ func StringLenBetween(x, y int) Validate[string] {
 		minimal := min(x, y)
 		maximal := max(x, y)
 		if len(s) < minimal || len(s) > maximal {
-			return fmt.Errorf("must contain from %d to %d characters", minimal, maximal)
+			return errorf("must contain from %d to %d characters", minimal, maximal)
 		}
 		return nil
 	}
@@ -56,7 +56,7 @@ func StringLenNotBetween(x, y int) Validate[string] {
 		minimal := min(x, y)
 		maximal := max(x, y)
 		if len(s) >= minimal && len(s) <= maximal {
-			return fmt.Errorf("must contain up to %d or more than %d characters", minimal, maximal)
+			return errorf("must contain up to %d or more than %d characters", minimal, maximal)
 		}
 		return nil
 	}
@@ -66,7 +66,7 @@ func StringLenNotBetween(x, y int) Validate[string] {
 func StringUTF8() Validate[string] {
 	return func(s string) error {
 		if !utf8.ValidString(s) {
-			return fmt.Errorf("must be utf-8 valid string")
+			return errorf("must be utf-8 valid string")
 		}
 		return nil
 	}
@@ -76,7 +76,7 @@ func StringUTF8() Validate[string] {
 func StringRuneCount(n int) Validate[string] {
 	return func(s string) error {
 		if utf8.RuneCountInString(s) != n {
-			return fmt.Errorf("must contain exactly %d characters", n)
+			return errorf("must contain exactly %d characters", n)
 		}
 		return nil
 	}
@@ -86,7 +86,7 @@ func StringRuneCount(n int) Validate[string] {
 func StringMinRuneCount(n int) Validate[string] {
 	return func(s string) error {
 		if utf8.RuneCountInString(s) < n {
-			return fmt.Errorf("must contain at least %d characters", n)
+			return errorf("must contain at least %d characters", n)
 		}
 		return nil
 	}
@@ -96,7 +96,7 @@ func StringMinRuneCount(n int) Validate[string] {
 func StringMaxRuneCount(n int) Validate[string] {
 	return func(s string) error {
 		if utf8.RuneCountInString(s) > n {
-			return fmt.Errorf("must contain at most %d characters", n)
+			return errorf("must contain at most %d characters", n)
 		}
 		return nil
 	}
@@ -109,7 +109,7 @@ func StringRuneCountBetween(x, y int) Validate[string] {
 		maximal := max(x, y)
 		count := utf8.RuneCountInString(s)
 		if count < minimal || count > maximal {
-			return fmt.Errorf("must contain from %d to %d characters", minimal, maximal)
+			return errorf("must contain from %d to %d characters", minimal, maximal)
 		}
 		return nil
 	}
@@ -122,26 +122,61 @@ func StringRuneCountNotBetween(x, y int) Validate[string] {
 		maximal := max(x, y)
 		count := utf8.RuneCountInString(s)
 		if count >= minimal && count <= maximal {
-			return fmt.Errorf("must contain up to %d or more than %d characters", minimal, maximal)
+			return errorf("must contain up to %d or more than %d characters", minimal, maximal)
 		}
 		return nil
 	}
 }
 
+// runeSet is a set of runes that tracks ASCII runes in a bitset and the first non-ASCII runes in a fixed array,
+// so the runes of short strings are collected without allocations.
+type runeSet struct {
+	ascii [2]uint64
+	small [32]rune
+	n     int
+	other map[rune]bool
+	count int
+}
+
+// add adds the rune to the set.
+func (set *runeSet) add(char rune) {
+	if char < utf8.RuneSelf {
+		bit := uint64(1) << (char % 64)
+		if set.ascii[char/64]&bit == 0 {
+			set.ascii[char/64] |= bit
+			set.count++
+		}
+		return
+	}
+	if slices.Contains(set.small[:set.n], char) || set.other[char] {
+		return
+	}
+	set.count++
+	if set.n < len(set.small) {
+		set.small[set.n] = char
+		set.n++
+		return
+	}
+	if set.other == nil {
+		set.other = make(map[rune]bool)
+	}
+	set.other[char] = true
+}
+
 // uniqueRuneCount returns the number of unique runes in the string.
 func uniqueRuneCount(s string) int {
-	unique := make(map[rune]bool)
+	var set runeSet
 	for _, char := range s {
-		unique[char] = true
+		set.add(char)
 	}
-	return len(unique)
+	return set.count
 }
 
 // StringUniqueRuneCount returns a validation function that checks whether the number of unique runes in the string is exactly equal to the specified number.
 func StringUniqueRuneCount(n int) Validate[string] {
 	return func(s string) error {
 		if uniqueRuneCount(s) != n {
-			return fmt.Errorf("must contain exactly %d unique characters", n)
+			return errorf("must contain exactly %d unique characters", n)
 		}
 		return nil
 	}
@@ -151,7 +186,7 @@ func StringUniqueRuneCount(n int) Validate[string] {
 func StringMinUniqueRuneCount(n int) Validate[string] {
 	return func(s string) error {
 		if uniqueRuneCount(s) < n {
-			return fmt.Errorf("must contain at least %d unique characters", n)
+			return errorf("must contain at least %d unique characters", n)
 		}
 		return nil
 	}
@@ -160,8 +195,8 @@ func StringMinUniqueRuneCount(n int) Validate[string] {
 // StringMaxUniqueRuneCount returns a validation function that checks whether the number of unique runes in the string is at most the specified number.
 func StringMaxUniqueRuneCount(n int) Validate[string] {
 	return func(s string) error {
-		if uniqueRuneCount(s) < n {
-			return fmt.Errorf("must contain at most %d unique characters", n)
+		if uniqueRuneCount(s) > n {
+			return errorf("must contain at most %d unique characters", n)
 		}
 		return nil
 	}
@@ -174,7 +209,7 @@ func StringUniqueRuneCountBetween(x, y int) Validate[string] {
 		maximal := max(x, y)
 		count := uniqueRuneCount(s)
 		if count < minimal || count > maximal {
-			return fmt.Errorf("must contain from %d to %d unique characters", minimal, maximal)
+			return errorf("must contain from %d to %d unique characters", minimal, maximal)
 		}
 		return nil
 	}
@@ -187,7 +222,7 @@ func StringUniqueRuneCountNotBetween(x, y int) Validate[string] {
 		maximal := max(x, y)
 		count := uniqueRuneCount(s)
 		if count >= minimal && count <= maximal {
-			return fmt.Errorf("must contain up to %d or more than %d unique characters", minimal, maximal)
+			return errorf("must contain up to %d or more than %d unique characters", minimal, maximal)
 		}
 		return nil
 	}
@@ -197,7 +232,7 @@ func StringUniqueRuneCountNotBetween(x, y int) Validate[string] {
 func StringContains(substr string) Validate[string] {
 	return func(s string) error {
 		if !strings.Contains(s, substr) {
-			return fmt.Errorf("must contain %q", substr)
+			return errorf("must contain %q", substr)
 		}
 		return nil
 	}
@@ -207,7 +242,7 @@ func StringContains(substr string) Validate[string] {
 func StringNotContains(substr string) Validate[string] {
 	return func(s string) error {
 		if strings.Contains(s, substr) {
-			return fmt.Errorf("must not contain %q", substr)
+			return errorf("must not contain %q", substr)
 		}
 		return nil
 	}
@@ -217,7 +252,7 @@ func StringNotContains(substr string) Validate[string] {
 func StringHasPrefix(prefix string) Validate[string] {
 	return func(s string) error {
 		if !strings.HasPrefix(s, prefix) {
-			return fmt.Errorf("must contain prefix %q", prefix)
+			return errorf("must contain prefix %q", prefix)
 		}
 		return nil
 	}
@@ -227,7 +262,7 @@ func StringHasPrefix(prefix string) Validate[string] {
 func StringNotHasPrefix(prefix string) Validate[string] {
 	return func(s string) error {
 		if strings.HasPrefix(s, prefix) {
-			return fmt.Errorf("must not contain prefix %q", prefix)
+			return errorf("must not contain prefix %q", prefix)
 		}
 		return nil
 	}
@@ -237,7 +272,7 @@ func StringNotHasPrefix(prefix string) Validate[string] {
 func StringHasSuffix(suffix string) Validate[string] {
 	return func(s string) error {
 		if !strings.HasSuffix(s, suffix) {
-			return fmt.Errorf("must contain suffix %q", suffix)
+			return errorf("must contain suffix %q", suffix)
 		}
 		return nil
 	}
@@ -246,8 +281,8 @@ func StringHasSuffix(suffix string) Validate[string] {
 // StringNotHasSuffix returns a validation function that checks whether the string does not end with suffix.
 func StringNotHasSuffix(suffix string) Validate[string] {
 	return func(s string) error {
-		if !strings.HasSuffix(s, suffix) {
-			return fmt.Errorf("must not contain suffix %q", suffix)
+		if strings.HasSuffix(s, suffix) {
+			return errorf("must not contain suffix %q", suffix)
 		}
 		return nil
 	}
@@ -340,31 +375,15 @@ func StringUnicodeDigits() Validate[string] {
 
 // StringAllow returns a validation function that checks whether the string contains only allowed characters.
 func StringAllow(charset string) Validate[string] {
-	return func(s string) error {
-		for _, char := range s {
-			if !strings.ContainsRune(charset, char) {
-				return fmt.Errorf("must contain only allowed characters: %q", charset)
-			}
-		}
-		return nil
-	}
+	return StringAllowCharset(CharsetOf(charset))
 }
 
 // StringNotAllow returns a validation function that checks whether the string does not contain disallowed characters.
 func StringNotAllow(charset string) Validate[string] {
-	return func(s string) error {
-		if strings.ContainsAny(s, charset) {
-			return fmt.Errorf("must not contain disallowed characters: %q", charset)
-		}
-		return nil
-	}
+	return StringNotAllowCharset(CharsetOf(charset))
 }
 
+// StringContainsAny returns a validation function that checks whether the string contains at least one of the characters.
 func StringContainsAny(charset string) Validate[string] {
-	return func(s string) error {
-		if !strings.ContainsAny(s, charset) {
-			return fmt.Errorf("must contain one of characters: %q", charset)
-		}
-		return nil
-	}
+	return StringContainsAnyCharset(CharsetOf(charset))
 }
@@ -0,0 +1,27 @@
+package please
+
+import "testing"
+
+func TestStringInvertedChecks(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  Validate[string]
+		input string
+		want  string
+	}{
+		{"max unique below", StringMaxUniqueRuneCount(3), "aab", ""},
+		{"max unique equal", StringMaxUniqueRuneCount(3), "abcabc", ""},
+		{"max unique above", StringMaxUniqueRuneCount(3), "abcd", "must contain at most 3 unique characters"},
+		{"not suffix absent", StringNotHasSuffix(".exe"), "setup.msi", ""},
+		{"not suffix present", StringNotHasSuffix(".exe"), "setup.exe", `must not contain suffix ".exe"`},
+		{"suffix present", StringHasSuffix(".exe"), "setup.exe", ""},
+		{"suffix absent", StringHasSuffix(".exe"), "setup.msi", `must contain suffix ".exe"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorString(tt.rule(tt.input)); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package please
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// SuggestionError is an error with the allowed values closest to the invalid value, like "did you mean "pending"?".
+type SuggestionError struct {
+	Err         error
+	Suggestions []string
+}
+
+// Error returns the error message of the underlying error followed by the suggestions.
+func (e *SuggestionError) Error() string {
+	quoted := make([]string, 0, len(e.Suggestions))
+	for _, s := range e.Suggestions {
+		quoted = append(quoted, strconv.Quote(s))
+	}
+	return e.Err.Error() + ", did you mean " + strings.Join(quoted, " or ") + "?"
+}
+
+// Unwrap returns the underlying error.
+func (e *SuggestionError) Unwrap() error {
+	return e.Err
+}
+
+// suggest wraps the error with the suggestions, if there are any.
+func suggest(err error, suggestions []string) error {
+	if len(suggestions) == 0 {
+		return err
+	}
+	return &SuggestionError{Err: err, Suggestions: suggestions}
+}
+
+// formatAll returns the values formatted with the default format.
+func formatAll[T any](values []T) []string {
+	s := make([]string, 0, len(values))
+	for _, v := range values {
+		s = append(s, fmt.Sprint(v))
+	}
+	return s
+}
+
+// isString reports whether the type is of string kind, only the values of such types are suggested,
+// since the edit distance between formatted numbers or structs suggests unrelated values.
+func isString[T any]() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.String
+}
+
+// maxSuggestions is the maximum number of suggestions returned by Suggest.
+const maxSuggestions = 3
+
+// Suggest returns up to three candidates closest to the value by Damerau-Levenshtein distance, ordered by distance.
+// Candidates are considered close if at most a third of the value characters, but at least one, has to be edited,
+// and at least one character is left intact.
+func Suggest(value string, candidates ...string) []string {
+	return SuggestWithin(value, 0, candidates...)
+}
+
+// SuggestWithin returns up to three candidates closest to the value by Damerau-Levenshtein distance, ordered by distance,
+// considering candidates close if at most maxDistance characters have to be edited and at least one character is left intact.
+// Zero maxDistance uses the threshold of Suggest, negative maxDistance returns no suggestions.
+func SuggestWithin(value string, maxDistance int, candidates ...string) []string {
+	var suggestions []string
+	for _, i := range closest(value, candidates, maxDistance) {
+		suggestions = append(suggestions, candidates[i])
+	}
+	return suggestions
+}
+
+// closest returns the indexes of up to three distinct candidates closest to the value, ordered by distance,
+// within the maximum distance, or within a third of the value characters if the maximum distance is zero.
+func closest(value string, candidates []string, maxDistance int) []int {
+	if maxDistance < 0 {
+		return nil
+	}
+	n := len([]rune(value))
+	threshold := maxDistance
+	if threshold == 0 {
+		threshold = max(1, n/3)
+	}
+	type match struct {
+		index    int
+		distance int
+	}
+	var matches []match
+	for i, c := range candidates {
+		if d := distance(value, c); d > 0 && d <= threshold && d < n {
+			matches = append(matches, match{index: i, distance: d})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b match) int {
+		return cmp.Compare(a.distance, b.distance)
+	})
+	var indexes []int
+	for _, m := range matches {
+		if len(indexes) == maxSuggestions {
+			break
+		}
+		if !slices.ContainsFunc(indexes, func(i int) bool { return candidates[i] == candidates[m.index] }) {
+			indexes = append(indexes, m.index)
+		}
+	}
+	return indexes
+}
+
+// distance returns the Damerau-Levenshtein distance (optimal string alignment) between the strings:
+// the number of insertions, deletions, substitutions and transpositions of adjacent characters.
+func distance(x, y string) int {
+	a, b := []rune(x), []rune(y)
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return prev[len(b)]
+}
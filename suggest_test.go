@@ -0,0 +1,61 @@
+package please
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestSuggestWithin(t *testing.T) {
+	candidates := []string{"pending", "active", "archived", "paused"}
+	tests := []struct {
+		value       string
+		maxDistance int
+		want        []string
+	}{
+		{"pendng", 0, []string{"pending"}},
+		{"actvie", 0, []string{"active"}},
+		{"pasued", 0, []string{"paused"}},
+		{"pnding", 0, []string{"pending"}},
+		{"arcvd", 0, nil},
+		{"arcvd", 3, []string{"archived"}},
+		{"pendng", -1, nil},
+		{"x", 5, nil},
+		{"pending", 0, nil},
+	}
+	for _, tt := range tests {
+		if got := SuggestWithin(tt.value, tt.maxDistance, candidates...); !slices.Equal(got, tt.want) {
+			t.Errorf("SuggestWithin(%q, %d) = %q, want %q", tt.value, tt.maxDistance, got, tt.want)
+		}
+	}
+}
+
+func TestEnumSuggestions(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want []string
+	}{
+		{"string", NewEnum("pending", "active").OneOf()("pendng"), []string{"pending"}},
+		{"string kind", NewEnum[testStatus]("pending", "active").OneOf()("activ"), []string{"active"}},
+		{"distance", NewEnum("pending", "active").SuggestWithin(3).OneOf()("pndg"), []string{"pending"}},
+		{"disabled", NewEnum("pending", "active").SuggestWithin(-1).OneOf()("pendng"), nil},
+		{"int", NewEnum(10, 20, 100).OneOf()(11), nil},
+		{"int map", OneIn(map[int]bool{10: true, 100: true})(11), nil},
+		{"string map", OneIn(map[string]bool{"pending": true})("pendng"), []string{"pending"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var se *SuggestionError
+			var got []string
+			if errors.As(tt.err, &se) {
+				got = se.Suggestions
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+type testStatus string
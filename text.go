@@ -0,0 +1,18 @@
+package please
+
+// StringOf returns a validation function that checks a value of a named string type with the string validation functions,
+// like StringOf[Username](StringMinLen(3), StringAlphaNumeric()).
+func StringOf[S ~string](opts ...Validate[string]) Validate[S] {
+	return func(s S) error {
+		return Join(string(s), opts...)
+	}
+}
+
+// BytesOf returns a validation function that checks a byte slice with the string validation functions,
+// like BytesOf[[]byte](StringUTF8(), StringMaxLen(1024)).
+// The byte slice is copied into a string on each validation, so the validation functions may keep the string.
+func BytesOf[B ~[]byte](opts ...Validate[string]) Validate[B] {
+	return func(b B) error {
+		return Join(string(b), opts...)
+	}
+}
@@ -0,0 +1,28 @@
+package please
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBytesOf(t *testing.T) {
+	validate := BytesOf[[]byte](StringUTF8(), StringMaxLen(8), Nothing[string]())
+	if err := validate([]byte("hello")); err != nil {
+		t.Errorf("got %v", err)
+	}
+	if err := validate([]byte("hello, world")); err == nil {
+		t.Error("12 bytes passed StringMaxLen(8)")
+	}
+	if err := validate(nil); err != nil {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestBytesOfErrorDoesNotAliasInput(t *testing.T) {
+	buf := []byte("abc")
+	err := BytesOf[[]byte](StringHasPrefix("x"), EqualFold("xyz"))(buf)
+	copy(buf, "zzz")
+	if err == nil || !bytes.Contains([]byte(err.Error()), []byte("abc")) {
+		t.Errorf("got %v, want the message about abc", err)
+	}
+}
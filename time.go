@@ -0,0 +1,253 @@
+package please
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+// Clock provides the current time for the time validation functions, so the rules are deterministic in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the clock of the system time.
+type systemClock struct{}
+
+// Now returns the current system time.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// fixedClock is the clock that always returns the same time.
+type fixedClock time.Time
+
+// Now returns the fixed time.
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+// SystemClock returns the clock of the system time.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+// FixedClock returns the clock that always returns the specified time, like FixedClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) in tests.
+func FixedClock(now time.Time) Clock {
+	return fixedClock(now)
+}
+
+// locationClock is the clock that returns the current time of another clock in a location.
+type locationClock struct {
+	clock Clock
+	loc   *time.Location
+}
+
+// Now returns the current time of the clock in the location.
+func (c locationClock) Now() time.Time {
+	return c.clock.Now().In(c.loc)
+}
+
+// ClockIn returns the clock that returns the current time of the clock in the location,
+// like ClockIn(SystemClock(), loc) for the calendar date of a user in another time zone.
+func ClockIn(clock Clock, loc *time.Location) Clock {
+	return locationClock{clock: clock, loc: loc}
+}
+
+// TimeBefore returns a validation function that checks whether the time is before the target time.
+func TimeBefore(target time.Time) Validate[time.Time] {
+	return func(t time.Time) error {
+		if !t.Before(target) {
+			return errorf("%v must be before %v", t, target)
+		}
+		return nil
+	}
+}
+
+// TimeAfter returns a validation function that checks whether the time is after the target time.
+func TimeAfter(target time.Time) Validate[time.Time] {
+	return func(t time.Time) error {
+		if !t.After(target) {
+			return errorf("%v must be after %v", t, target)
+		}
+		return nil
+	}
+}
+
+// TimeNotBefore returns a validation function that checks whether the time is equal to or after the target time.
+func TimeNotBefore(target time.Time) Validate[time.Time] {
+	return func(t time.Time) error {
+		if t.Before(target) {
+			return errorf("%v must not be before %v", t, target)
+		}
+		return nil
+	}
+}
+
+// TimeNotAfter returns a validation function that checks whether the time is equal to or before the target time.
+func TimeNotAfter(target time.Time) Validate[time.Time] {
+	return func(t time.Time) error {
+		if t.After(target) {
+			return errorf("%v must not be after %v", t, target)
+		}
+		return nil
+	}
+}
+
+// TimeBetween returns a validation function that checks whether the time is between the times, including the bounds.
+func TimeBetween(x, y time.Time) Validate[time.Time] {
+	if y.Before(x) {
+		x, y = y, x
+	}
+	return func(t time.Time) error {
+		if t.Before(x) || t.After(y) {
+			return errorf("%v must be between %v and %v", t, x, y)
+		}
+		return nil
+	}
+}
+
+// TimeBetweenExclusive returns a validation function that checks whether the time is between the times, excluding the bounds.
+func TimeBetweenExclusive(x, y time.Time) Validate[time.Time] {
+	if y.Before(x) {
+		x, y = y, x
+	}
+	return func(t time.Time) error {
+		if !t.After(x) || !t.Before(y) {
+			return errorf("%v must be strictly between %v and %v", t, x, y)
+		}
+		return nil
+	}
+}
+
+// TimeNotZero returns a validation function that checks whether the time is not the zero time.
+func TimeNotZero() Validate[time.Time] {
+	return func(t time.Time) error {
+		if t.IsZero() {
+			return errors.New("must not be zero time")
+		}
+		return nil
+	}
+}
+
+// TimeInLocation returns a validation function that checks whether the time is in the location, like TimeInLocation(time.UTC).
+func TimeInLocation(loc *time.Location) Validate[time.Time] {
+	return func(t time.Time) error {
+		if t.Location().String() != loc.String() {
+			return errorf("%v must be in %s location", t, loc)
+		}
+		return nil
+	}
+}
+
+// TimeTruncated returns a validation function that checks whether the time is a multiple of the duration since the zero time,
+// like TimeTruncated(time.Hour). Like time.Truncate it works on the absolute time, use TimeDateOnly for the local midnight.
+func TimeTruncated(d time.Duration) Validate[time.Time] {
+	return func(t time.Time) error {
+		if !t.Truncate(d).Equal(t) {
+			return errorf("%v must be truncated to %v", t, d)
+		}
+		return nil
+	}
+}
+
+// TimeDateOnly returns a validation function that checks whether the time is the midnight in its location.
+func TimeDateOnly() Validate[time.Time] {
+	return func(t time.Time) error {
+		if hour, minute, second := t.Clock(); hour != 0 || minute != 0 || second != 0 || t.Nanosecond() != 0 {
+			return errorf("%v must be a date without time of day", t)
+		}
+		return nil
+	}
+}
+
+// TimeWeekday returns a validation function that checks whether the weekday of the time in its location is one of the weekdays.
+func TimeWeekday(weekdays ...time.Weekday) Validate[time.Time] {
+	weekdays = slices.Clone(weekdays)
+	return func(t time.Time) error {
+		if !slices.Contains(weekdays, t.Weekday()) {
+			return errorf("%v must be on %v", t, weekdays)
+		}
+		return nil
+	}
+}
+
+// TimeNotInFuture returns a validation function that checks whether the time is not after the current time of the clock.
+func TimeNotInFuture(clock Clock) Validate[time.Time] {
+	return func(t time.Time) error {
+		if t.After(clock.Now()) {
+			return errorf("%v must not be in the future", t)
+		}
+		return nil
+	}
+}
+
+// TimeNotInPast returns a validation function that checks whether the time is not before the current time of the clock.
+func TimeNotInPast(clock Clock) Validate[time.Time] {
+	return func(t time.Time) error {
+		if t.Before(clock.Now()) {
+			return errorf("%v must not be in the past", t)
+		}
+		return nil
+	}
+}
+
+// TimeWithinLast returns a validation function that checks whether the time is within the duration before the current time
+// of the clock, like TimeWithinLast(clock, 30*24*time.Hour). Times in the future are not within the last duration.
+func TimeWithinLast(clock Clock, d time.Duration) Validate[time.Time] {
+	return func(t time.Time) error {
+		now := clock.Now()
+		if t.Before(now.Add(-d)) || t.After(now) {
+			return errorf("%v must be within the last %v", t, d)
+		}
+		return nil
+	}
+}
+
+// TimeWithinNext returns a validation function that checks whether the time is within the duration after the current time
+// of the clock. Times in the past are not within the next duration.
+func TimeWithinNext(clock Clock, d time.Duration) Validate[time.Time] {
+	return func(t time.Time) error {
+		now := clock.Now()
+		if t.Before(now) || t.After(now.Add(d)) {
+			return errorf("%v must be within the next %v", t, d)
+		}
+		return nil
+	}
+}
+
+// StringTime returns a validation function that checks whether the string is a time in the layout,
+// and checks the parsed time with the validation functions, like StringTime(time.DateOnly, TimeNotInFuture(SystemClock())).
+func StringTime(layout string, opts ...Validate[time.Time]) Validate[string] {
+	return func(s string) error {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			return errorf("must be a time in %q layout", layout)
+		}
+		return Join(t, opts...)
+	}
+}
+
+// StringRFC3339 returns a validation function that checks whether the string is an RFC 3339 time with optional fractional seconds,
+// and checks the parsed time with the validation functions.
+func StringRFC3339(opts ...Validate[time.Time]) Validate[string] {
+	return func(s string) error {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return errors.New("must be an RFC 3339 time")
+		}
+		return Join(t, opts...)
+	}
+}
+
+// StringDuration returns a validation function that checks whether the string is a duration like "1h30m" or "250ms",
+// and checks the parsed duration with the validation functions, like StringDuration(Between(time.Second, time.Minute)).
+func StringDuration(opts ...Validate[time.Duration]) Validate[string] {
+	return func(s string) error {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return errors.New(`must be a duration, like "1h30m"`)
+		}
+		return Join(d, opts...)
+	}
+}
@@ -0,0 +1,124 @@
+package please
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeWithin(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	clock := FixedClock(now)
+	day := 24 * time.Hour
+	tests := []struct {
+		name string
+		rule Validate[time.Time]
+		t    time.Time
+		ok   bool
+	}{
+		{"last now", TimeWithinLast(clock, day), now, true},
+		{"last start", TimeWithinLast(clock, day), now.Add(-day), true},
+		{"last before start", TimeWithinLast(clock, day), now.Add(-day - time.Nanosecond), false},
+		{"last future", TimeWithinLast(clock, day), now.Add(time.Nanosecond), false},
+		{"last other location", TimeWithinLast(clock, day), now.Add(-day).In(time.FixedZone("UTC-8", -8*60*60)), true},
+		{"last zero duration", TimeWithinLast(clock, 0), now, true},
+		{"next now", TimeWithinNext(clock, day), now, true},
+		{"next end", TimeWithinNext(clock, day), now.Add(day), true},
+		{"next after end", TimeWithinNext(clock, day), now.Add(day + time.Nanosecond), false},
+		{"next past", TimeWithinNext(clock, day), now.Add(-time.Nanosecond), false},
+		{"not in future now", TimeNotInFuture(clock), now, true},
+		{"not in future", TimeNotInFuture(clock), now.Add(time.Nanosecond), false},
+		{"not in past now", TimeNotInPast(clock), now, true},
+		{"not in past", TimeNotInPast(clock), now.Add(-time.Nanosecond), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rule(tt.t); (err == nil) != tt.ok {
+				t.Errorf("got %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestTimeWithinLastMessage(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	err := TimeWithinLast(FixedClock(now), time.Hour)(now.Add(-2 * time.Hour))
+	if got, want := errorString(err), "2024-03-10 10:00:00 +0000 UTC must be within the last 1h0m0s"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestTimeRules(t *testing.T) {
+	base := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	later := base.Add(time.Hour)
+	almaty := time.FixedZone("Asia/Almaty", 5*60*60)
+	tests := []struct {
+		name string
+		rule Validate[time.Time]
+		t    time.Time
+		ok   bool
+	}{
+		{"before", TimeBefore(later), base, true},
+		{"before equal", TimeBefore(base), base, false},
+		{"after equal", TimeAfter(base), base, false},
+		{"not before equal", TimeNotBefore(base), base, true},
+		{"not after equal", TimeNotAfter(base), base, true},
+		{"not after", TimeNotAfter(base), later, false},
+		{"between bounds", TimeBetween(later, base), base, true},
+		{"between outside", TimeBetween(base, later), later.Add(1), false},
+		{"exclusive bound", TimeBetweenExclusive(later, base), base, false},
+		{"exclusive inside", TimeBetweenExclusive(base, later), base.Add(1), true},
+		{"not zero", TimeNotZero(), time.Time{}, false},
+		{"location", TimeInLocation(time.UTC), base, true},
+		{"other location", TimeInLocation(time.UTC), base.In(almaty), false},
+		{"truncated", TimeTruncated(time.Hour), later, true},
+		{"not truncated", TimeTruncated(time.Hour), later.Add(time.Minute), false},
+		{"date only", TimeDateOnly(), base, true},
+		{"date only other location", TimeDateOnly(), base.In(almaty), false},
+		{"date only nanosecond", TimeDateOnly(), base.Add(1), false},
+		{"weekday", TimeWeekday(time.Saturday, time.Sunday), base, true},
+		{"weekday in location", TimeWeekday(time.Sunday), base.Add(-time.Hour).In(almaty), true},
+		{"not weekday", TimeWeekday(time.Monday), base, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rule(tt.t); (err == nil) != tt.ok {
+				t.Errorf("got %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestStringTime(t *testing.T) {
+	clock := FixedClock(time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name string
+		rule Validate[string]
+		s    string
+		want string
+	}{
+		{"date", StringTime(time.DateOnly, TimeNotInFuture(clock)), "2024-03-10", ""},
+		{"date layout", StringTime(time.DateOnly), "10.03.2024", `must be a time in "2006-01-02" layout`},
+		{"date future", StringTime(time.DateOnly, TimeNotInFuture(clock)), "2024-03-11", "2024-03-11 00:00:00 +0000 UTC must not be in the future"},
+		{"rfc3339", StringRFC3339(), "2024-03-10T12:00:00.123+05:00", ""},
+		{"rfc3339 invalid", StringRFC3339(), "2024-03-10 12:00", "must be an RFC 3339 time"},
+		{"duration", StringDuration(Between(time.Second, time.Minute)), "1m", ""},
+		{"duration invalid", StringDuration(), "1 hour", `must be a duration, like "1h30m"`},
+		{"duration outside", StringDuration(Between(time.Second, time.Minute)), "250ms", "250ms must be between 1s and 1m0s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorString(tt.rule(tt.s)); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClockIn(t *testing.T) {
+	now := time.Date(2024, 3, 10, 22, 0, 0, 0, time.UTC)
+	almaty := time.FixedZone("Asia/Almaty", 5*60*60)
+	got := ClockIn(FixedClock(now), almaty).Now()
+	if !got.Equal(now) || got.Location() != almaty || got.Day() != 11 {
+		t.Errorf("got %v, want %v in %v", got, now, almaty)
+	}
+}
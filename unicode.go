@@ -0,0 +1,154 @@
+package please
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// tableNames returns the names of the unicode range tables as defined in the unicode package.
+func tableNames(tables []*unicode.RangeTable) string {
+	names := make([]string, 0, len(tables))
+	for _, table := range tables {
+		names = append(names, tableName(table))
+	}
+	return strings.Join(names, ", ")
+}
+
+// tableName returns the name of the unicode range table as defined in the unicode package.
+func tableName(table *unicode.RangeTable) string {
+	for _, tables := range []map[string]*unicode.RangeTable{unicode.Scripts, unicode.Categories, unicode.Properties} {
+		for name, t := range tables {
+			if t == table {
+				return name
+			}
+		}
+	}
+	return "custom"
+}
+
+// tablesCharset returns a charset of the characters in any of the unicode range tables.
+func tablesCharset(tables []*unicode.RangeTable) Charset {
+	tables = slices.Clone(tables)
+	c := newCharset(tableNames(tables), func(char rune) bool {
+		return unicode.In(char, tables...)
+	})
+	c.composite = len(tables) > 1
+	return c
+}
+
+// StringScripts returns a validation function that checks whether the string contains only characters of the specified scripts,
+// like unicode.Cyrillic and unicode.Latin. Spaces, digits and punctuation belong to unicode.Common script.
+func StringScripts(scripts ...*unicode.RangeTable) Validate[string] {
+	c := tablesCharset(scripts)
+	return func(s string) error {
+		if !c.ContainsAll(s) {
+			return errorf("must contain only characters of scripts: %s", c)
+		}
+		return nil
+	}
+}
+
+// scriptNames returns the sorted names of the unicode scripts except Common and Inherited.
+var scriptNames = sync.OnceValue(func() []string {
+	names := make([]string, 0, len(unicode.Scripts))
+	for name := range unicode.Scripts {
+		if name != "Common" && name != "Inherited" {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+})
+
+// scriptOf returns the name of the script of the character, the hint is checked first.
+func scriptOf(char rune, hint string) string {
+	if hint != "" && unicode.Is(unicode.Scripts[hint], char) {
+		return hint
+	}
+	for _, name := range scriptNames() {
+		if unicode.Is(unicode.Scripts[name], char) {
+			return name
+		}
+	}
+	return ""
+}
+
+// StringSingleScript returns a validation function that checks whether the string does not mix characters of different scripts,
+// which is a common way to spoof identifiers. Characters of unicode.Common and unicode.Inherited scripts are allowed with any script.
+func StringSingleScript() Validate[string] {
+	return func(s string) error {
+		first := ""
+		for _, char := range s {
+			if unicode.In(char, unicode.Common, unicode.Inherited) {
+				continue
+			}
+			script := scriptOf(char, first)
+			if first == "" {
+				first = script
+				continue
+			}
+			if script != first {
+				return errorf("must not mix characters of scripts: %s, %s", first, script)
+			}
+		}
+		return nil
+	}
+}
+
+// StringCategories returns a validation function that checks whether the string contains only characters of the specified categories,
+// like unicode.L and unicode.Nd.
+func StringCategories(categories ...*unicode.RangeTable) Validate[string] {
+	c := tablesCharset(categories)
+	return func(s string) error {
+		if !c.ContainsAll(s) {
+			return errorf("must contain only characters of categories: %s", c)
+		}
+		return nil
+	}
+}
+
+// StringNotCategories returns a validation function that checks whether the string does not contain characters of the specified categories,
+// like unicode.Cc, unicode.Cf, unicode.M, unicode.P and unicode.S.
+func StringNotCategories(categories ...*unicode.RangeTable) Validate[string] {
+	c := tablesCharset(categories)
+	return func(s string) error {
+		if c.ContainsAny(s) {
+			return errorf("must not contain characters of categories: %s", c)
+		}
+		return nil
+	}
+}
+
+// invisible is a charset of the characters that are rendered without a visible glyph:
+// format characters, variation selectors, hangul fillers and braille blank.
+var invisible = sync.OnceValue(func() Charset {
+	return tablesCharset([]*unicode.RangeTable{unicode.Cf, unicode.Variation_Selector}).
+		Union(CharsetOf("\u115f\u1160\u3164\uffa0\u2800"))
+})
+
+// StringNotInvisible returns a validation function that checks whether the string does not contain invisible characters,
+// like zero width spaces and joiners, bidirectional controls, variation selectors and hangul fillers.
+func StringNotInvisible() Validate[string] {
+	return func(s string) error {
+		if invisible().ContainsAny(s) {
+			return errors.New("must not contain invisible characters")
+		}
+		return nil
+	}
+}
+
+// StringNotBidiControl returns a validation function that checks whether the string does not contain bidirectional control characters,
+// like right-to-left override, which can reorder the displayed text.
+func StringNotBidiControl() Validate[string] {
+	return func(s string) error {
+		for _, char := range s {
+			if unicode.Is(unicode.Bidi_Control, char) {
+				return errors.New("must not contain bidirectional control characters")
+			}
+		}
+		return nil
+	}
+}
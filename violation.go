@@ -0,0 +1,128 @@
+package please
+
+import (
+	"errors"
+	"strings"
+)
+
+// Violation is a single validation error extracted from an error tree.
+type Violation struct {
+	// Path is the path of the field the error belongs to, like "users[0].name", or empty for the root value.
+	Path string
+	// Message is the error message of the leaf error.
+	Message string
+	// Err is the leaf error.
+	Err error
+	// Chain is the list of errors wrapping the leaf error, from the outermost to the innermost.
+	// Field errors and joined errors are not included in the chain, other errors wrapping multiple errors are.
+	Chain []error
+	// Suggestions is the list of allowed values closest to the invalid value, if the error has any.
+	Suggestions []string
+}
+
+// Violations walks the error tree and returns the leaf errors with their field paths and wrap chains.
+// Errors are unwrapped using both Unwrap() error and Unwrap() []error methods, identical violations are reported once.
+func Violations(err error) []Violation {
+	var list []Violation
+	seen := make(map[[2]string]bool)
+	walkViolations(err, "", nil, func(v Violation) {
+		key := [2]string{v.Path, v.Message}
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		list = append(list, v)
+	})
+	return list
+}
+
+// Flatten walks the error tree and returns the leaf errors annotated with their field paths.
+func Flatten(err error) []error {
+	violations := Violations(err)
+	if len(violations) == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(violations))
+	for _, v := range violations {
+		if v.Path == "" {
+			errs = append(errs, v.Err)
+			continue
+		}
+		errs = append(errs, &FieldError{Field: v.Path, Err: v.Err})
+	}
+	return errs
+}
+
+// walkViolations calls the yield function for each leaf error of the error tree.
+func walkViolations(err error, path string, chain []error, yield func(Violation)) {
+	switch e := err.(type) {
+	case nil:
+		return
+	case *FieldError:
+		walkViolations(e.Err, JoinPath(path, e.Field), chain, yield)
+		return
+	case interface{ Unwrap() []error }:
+		errs := e.Unwrap()
+		if isJoin(err, errs) {
+			for _, inner := range errs {
+				walkViolations(inner, path, chain, yield)
+			}
+			return
+		}
+		// Other errors wrapping multiple errors, like fmt.Errorf("%w: %w", cause, err), are leaves unless they wrap
+		// field errors or joins. The structured errors are walked with the wrapper in the chain, the other wrapped
+		// errors, like the cause, are only kept in the message of the wrapper.
+		if hasStructure(errs...) {
+			chain = append(chain[:len(chain):len(chain)], err)
+			for _, inner := range errs {
+				if hasStructure(inner) {
+					walkViolations(inner, path, chain, yield)
+				}
+			}
+			return
+		}
+	case interface{ Unwrap() error }:
+		if inner := e.Unwrap(); hasStructure(inner) {
+			walkViolations(inner, path, append(chain[:len(chain):len(chain)], err), yield)
+			return
+		}
+	}
+	v := Violation{Path: path, Message: err.Error(), Err: err, Chain: chain}
+	var se *SuggestionError
+	if errors.As(err, &se) {
+		v.Suggestions = se.Suggestions
+	}
+	yield(v)
+}
+
+// hasStructure reports whether any of the error trees contains field errors or joined errors.
+func hasStructure(errs ...error) bool {
+	for _, err := range errs {
+		switch e := err.(type) {
+		case nil:
+			continue
+		case *FieldError:
+			return true
+		case interface{ Unwrap() []error }:
+			if isJoin(err, e.Unwrap()) || hasStructure(e.Unwrap()...) {
+				return true
+			}
+		case interface{ Unwrap() error }:
+			if hasStructure(e.Unwrap()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isJoin reports whether the error only joins the errors: its message consists of the newline separated messages of the errors, as errors.Join produces.
+func isJoin(err error, errs []error) bool {
+	msgs := make([]string, 0, len(errs))
+	for _, e := range errs {
+		if e != nil {
+			msgs = append(msgs, e.Error())
+		}
+	}
+	return err.Error() == strings.Join(msgs, "\n")
+}
@@ -0,0 +1,131 @@
+package please
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// multiError is a user-defined error wrapping multiple errors with its own message.
+type multiError []error
+
+func (m multiError) Error() string   { return fmt.Sprintf("%d errors", len(m)) }
+func (m multiError) Unwrap() []error { return m }
+
+func TestViolationsPaths(t *testing.T) {
+	err := errors.Join(
+		&FieldError{Field: "users", Err: &FieldError{Field: Index(0), Err: &FieldError{Field: "name", Err: errors.New("must not be empty")}}},
+		&FieldError{Field: "age", Err: errors.New("must be positive")},
+		errors.New("root error"),
+	)
+	var got [][2]string
+	for _, v := range Violations(err) {
+		got = append(got, [2]string{v.Path, v.Message})
+	}
+	want := [][2]string{
+		{"users[0].name", "must not be empty"},
+		{"age", "must be positive"},
+		{"", "root error"},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestViolationsUserDefinedMultiError(t *testing.T) {
+	err := multiError{
+		&FieldError{Field: "name", Err: errors.New("too short")},
+		&FieldError{Field: "email", Err: errors.New("invalid")},
+	}
+	violations := Violations(err)
+	if len(violations) != 2 {
+		t.Fatalf("got %d violations, want 2", len(violations))
+	}
+	if v := violations[0]; v.Path != "name" || v.Message != "too short" {
+		t.Errorf("got %q %q, want name too short", v.Path, v.Message)
+	}
+	for _, v := range violations {
+		if len(v.Chain) != 1 || v.Chain[0].Error() != "2 errors" {
+			t.Errorf("got chain %v, want the multi error", v.Chain)
+		}
+	}
+
+	leaves := multiError{errors.New("a"), errors.New("b")}
+	if violations := Violations(leaves); len(violations) != 1 || violations[0].Message != "2 errors" {
+		t.Errorf("got %+v, want the multi error of leaves as a single violation", violations)
+	}
+}
+
+func TestViolationsWrapError(t *testing.T) {
+	errName := errors.New("invalid name")
+	err := Field("name", StringMinLen(3).WrapError(errName))("a")
+	violations := Violations(err)
+	if len(violations) != 1 {
+		t.Fatalf("got %d violations, want 1", len(violations))
+	}
+	v := violations[0]
+	if v.Path != "name" || v.Message != "invalid name: must contain at least 3 characters" {
+		t.Errorf("got %q %q", v.Path, v.Message)
+	}
+	if !errors.Is(v.Err, errName) {
+		t.Errorf("got %v, want an error wrapping the cause", v.Err)
+	}
+
+	// A wrapped join keeps the wrapper in the chain of its field errors.
+	nested := errors.Join(&FieldError{Field: "first", Err: errors.New("required")})
+	err = fmt.Errorf("%w: %w", errName, nested)
+	violations = Violations(err)
+	if len(violations) != 1 || violations[0].Path != "first" || len(violations[0].Chain) != 1 || violations[0].Chain[0] != err {
+		t.Errorf("got %+v, want first with the wrapper in the chain", violations)
+	}
+}
+
+func TestViolationsChain(t *testing.T) {
+	leaf := errors.New("must be positive")
+	wrapped := fmt.Errorf("order: %w", errors.Join(&FieldError{Field: "qty", Err: leaf}))
+	violations := Violations(wrapped)
+	if len(violations) != 1 {
+		t.Fatalf("got %d violations, want 1", len(violations))
+	}
+	v := violations[0]
+	if v.Path != "qty" || v.Err != leaf {
+		t.Errorf("got %q %v, want qty %v", v.Path, v.Err, leaf)
+	}
+	if len(v.Chain) != 1 || v.Chain[0] != wrapped {
+		t.Errorf("got chain %v, want the wrapping error only", v.Chain)
+	}
+}
+
+func TestViolationsDeduplicate(t *testing.T) {
+	err := errors.Join(
+		&FieldError{Field: "name", Err: errors.New("required")},
+		&FieldError{Field: "name", Err: errors.New("required")},
+		&FieldError{Field: "email", Err: errors.New("required")},
+	)
+	if got := len(Violations(err)); got != 2 {
+		t.Errorf("got %d violations, want 2", got)
+	}
+}
+
+func TestViolationsSuggestions(t *testing.T) {
+	err := &FieldError{Field: "status", Err: OneOf("active", "pending")("pendng")}
+	violations := Violations(err)
+	if len(violations) != 1 || !slices.Equal(violations[0].Suggestions, []string{"pending"}) {
+		t.Errorf("got %+v, want a suggestion of pending", violations)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	err := errors.Join(&FieldError{Field: "a", Err: errors.New("x")}, errors.New("y"))
+	var got []string
+	for _, e := range Flatten(err) {
+		got = append(got, e.Error())
+	}
+	if want := []string{"a: x", "y"}; !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if Flatten(nil) != nil {
+		t.Error("got violations for nil error")
+	}
+}